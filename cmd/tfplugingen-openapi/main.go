@@ -38,8 +38,22 @@ func initCommands(ui cli.Ui) map[string]cli.CommandFactory {
 		}, nil
 	}
 
+	initFactory := func() (cli.Command, error) {
+		return &cmd.InitCommand{
+			UI: ui,
+		}, nil
+	}
+
+	validateConfigFactory := func() (cli.Command, error) {
+		return &cmd.ValidateConfigCommand{
+			UI: ui,
+		}, nil
+	}
+
 	return map[string]cli.CommandFactory{
-		"generate": generateFactory,
+		"generate":        generateFactory,
+		"init":            initFactory,
+		"validate-config": validateConfigFactory,
 	}
 }
 
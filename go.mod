@@ -10,6 +10,7 @@ require (
 	github.com/hashicorp/terraform-plugin-codegen-spec v0.2.0
 	github.com/mattn/go-colorable v0.1.14
 	github.com/pb33f/libopenapi v0.21.8
+	github.com/speakeasy-api/jsonpath v0.6.1
 	gopkg.in/yaml.v3 v3.0.1
 )
 
@@ -33,7 +34,6 @@ require (
 	github.com/mitchellh/reflectwalk v1.0.2 // indirect
 	github.com/posener/complete v1.2.3 // indirect
 	github.com/shopspring/decimal v1.3.1 // indirect
-	github.com/speakeasy-api/jsonpath v0.6.1 // indirect
 	github.com/spf13/cast v1.5.0 // indirect
 	github.com/wk8/go-ordered-map/v2 v2.1.9-0.20240815153524-6ea36470d1bd // indirect
 	github.com/xeipuuv/gojsonpointer v0.0.0-20180127040702-4e3ac2762d5f // indirect
@@ -6,12 +6,10 @@ package cmd
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"flag"
 	"fmt"
 	"log/slog"
 	"os"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
@@ -19,13 +17,12 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
 
 	"github.com/hashicorp/cli"
-	"github.com/pb33f/libopenapi"
-	"github.com/pb33f/libopenapi/index"
 )
 
 type GenerateCommand struct {
+	specFlags
+
 	UI             cli.Ui
-	oasInputPath   string
 	flagConfigPath string
 	flagOutputPath string
 }
@@ -34,45 +31,12 @@ func (cmd *GenerateCommand) Flags() *flag.FlagSet {
 	fs := flag.NewFlagSet("generate", flag.ExitOnError)
 	fs.StringVar(&cmd.flagConfigPath, "config", "./generator_config.yml", "path to generator config file (YAML)")
 	fs.StringVar(&cmd.flagOutputPath, "output", "./provider_code_spec.json", "destination file path for generated provider code spec (JSON)")
+	cmd.addFlags(fs)
 	return fs
 }
 
 func (cmd *GenerateCommand) Help() string {
-	strBuilder := &strings.Builder{}
-
-	longestName := 0
-	longestUsage := 0
-	cmd.Flags().VisitAll(func(f *flag.Flag) {
-		if len(f.Name) > longestName {
-			longestName = len(f.Name)
-		}
-		if len(f.Usage) > longestUsage {
-			longestUsage = len(f.Usage)
-		}
-	})
-
-	strBuilder.WriteString("\nUsage: tfplugingen-openapi generate [<args>] </path/to/oas_file.yml>\n\n")
-	cmd.Flags().VisitAll(func(f *flag.Flag) {
-		if f.DefValue != "" {
-			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
-				f.Name,
-				strings.Repeat(" ", longestName-len(f.Name)+2),
-				f.Usage,
-				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
-				f.DefValue,
-			))
-		} else {
-			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
-				f.Name,
-				strings.Repeat(" ", longestName-len(f.Name)+2),
-				f.Usage,
-				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
-			))
-		}
-	})
-	strBuilder.WriteString("\n")
-
-	return strBuilder.String()
+	return flagsHelp("generate [<args>] </path/to/oas_file.yml> [</path/to/oas_file_or_directory> ...]", cmd.Flags())
 }
 
 func (cmd *GenerateCommand) Synopsis() string {
@@ -91,9 +55,9 @@ func (cmd *GenerateCommand) Run(args []string) int {
 		return 1
 	}
 
-	cmd.oasInputPath = fs.Arg(0)
-	if cmd.oasInputPath == "" {
-		logger.Error("error executing command", "err", "OpenAPI specification file is required as last argument")
+	cmd.oasInputPaths = fs.Args()
+	if len(cmd.oasInputPaths) == 0 {
+		logger.Error("error executing command", "err", "at least one OpenAPI specification file or directory is required as last argument(s)")
 		return 1
 	}
 
@@ -117,49 +81,38 @@ func (cmd *GenerateCommand) runInternal(logger *slog.Logger) error {
 		return fmt.Errorf("error parsing generator config file: %w", err)
 	}
 
-	// 2. Read and parse OpenAPI spec file
-	oasBytes, err := os.ReadFile(cmd.oasInputPath)
-	if err != nil {
-		return fmt.Errorf("error reading OpenAPI spec file: %w", err)
-	}
-	doc, err := libopenapi.NewDocument(oasBytes)
+	// 2. Read and parse OpenAPI spec files, then build out the OpenAPI model
+	model, err := cmd.buildModel(logger, *config)
 	if err != nil {
-		return fmt.Errorf("error parsing OpenAPI spec file: %w", err)
+		return err
 	}
 
-	// 3. Build out the OpenAPI model, this will recursively load all local + remote references into one cohesive model
-	model, errs := doc.BuildV3Model()
-
-	// 4. Log circular references as warnings and fail on any other model building errors
-	var errResult error
-	for _, err := range errs {
-		if rslvErr, ok := err.(*index.ResolvingError); ok {
-			logger.Warn(
-				"circular reference found in OpenAPI spec",
-				"circular_ref", rslvErr.CircularReference.GenerateJourneyPath())
-			continue
-		}
-
-		errResult = errors.Join(errResult, err)
+	// 3. Log a warning for any ignores, overrides, and aliases in the generator config that don't match the OpenAPI spec
+	oasExplorer := newExplorer(model, *config)
+	mismatches, err := findConfigMismatches(oasExplorer, *config, configBytes)
+	if err != nil {
+		return err
 	}
-	if errResult != nil {
-		return fmt.Errorf("error building OpenAPI 3.x model: %w", errResult)
+	for _, mismatch := range mismatches {
+		logger.Warn(
+			"generator config schema option doesn't match OpenAPI spec",
+			"config_line", mismatch.Line,
+			"err", mismatch.Error())
 	}
 
-	// 5. Generate provider code spec w/ config
-	oasExplorer := explorer.NewConfigExplorer(model.Model, *config)
+	// 4. Generate provider code spec w/ config
 	providerCodeSpec, err := generateProviderCodeSpec(logger, oasExplorer, *config)
 	if err != nil {
 		return err
 	}
 
-	// 6. Use provider code spec to create JSON
+	// 5. Use provider code spec to create JSON
 	bytes, err := json.MarshalIndent(providerCodeSpec, "", "\t")
 	if err != nil {
 		return fmt.Errorf("error marshalling provider code spec to JSON: %w", err)
 	}
 
-	// 7. Log a warning if the provider code spec is not valid based on the JSON schema
+	// 6. Log a warning if the provider code spec is not valid based on the JSON schema
 	err = spec.Validate(context.TODO(), bytes)
 	if err != nil {
 		logger.Warn(
@@ -167,7 +120,7 @@ func (cmd *GenerateCommand) runInternal(logger *slog.Logger) error {
 			"validation_msg", err)
 	}
 
-	// 8. Output to file
+	// 7. Output to file
 	output, err := os.Create(cmd.flagOutputPath)
 	if err != nil {
 		return fmt.Errorf("error creating output file for provider code spec: %w", err)
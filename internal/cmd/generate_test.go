@@ -21,6 +21,7 @@ func TestGenerate_WithConfig(t *testing.T) {
 		oasSpecPath    string
 		configPath     string
 		goldenFilePath string
+		additionalArgs []string
 	}{
 		"GitHub v3 REST API": {
 			oasSpecPath:    "testdata/github/openapi_spec.json",
@@ -42,6 +43,33 @@ func TestGenerate_WithConfig(t *testing.T) {
 			configPath:     "testdata/edgecase/generator_config.yml",
 			goldenFilePath: "testdata/edgecase/provider_code_spec.json",
 		},
+		"Extensions API": {
+			oasSpecPath:    "testdata/extensions/openapi_spec.yml",
+			configPath:     "testdata/extensions/generator_config.yml",
+			goldenFilePath: "testdata/extensions/provider_code_spec.json",
+		},
+		"Multi-file API": {
+			oasSpecPath:    "testdata/multifile/spec/openapi_spec.yml",
+			configPath:     "testdata/multifile/generator_config.yml",
+			goldenFilePath: "testdata/multifile/provider_code_spec.json",
+			additionalArgs: []string{"--allowed-ref-root", "testdata/multifile/shared"},
+		},
+		"Overlay API": {
+			oasSpecPath:    "testdata/overlay/openapi_spec.yml",
+			configPath:     "testdata/overlay/generator_config.yml",
+			goldenFilePath: "testdata/overlay/provider_code_spec.json",
+			additionalArgs: []string{"--overlay", "testdata/overlay/overlay.yml"},
+		},
+		"Multi-spec API": {
+			oasSpecPath:    "testdata/multispec/specs",
+			configPath:     "testdata/multispec/generator_config.yml",
+			goldenFilePath: "testdata/multispec/provider_code_spec.json",
+		},
+		"Swagger 2.0 API": {
+			oasSpecPath:    "testdata/swagger2/openapi_spec.yml",
+			configPath:     "testdata/swagger2/generator_config.yml",
+			goldenFilePath: "testdata/swagger2/provider_code_spec.json",
+		},
 		"Kubernetes API": {
 			oasSpecPath:    "testdata/kubernetes/openapi_spec.json",
 			configPath:     "testdata/kubernetes/generator_config.yml",
@@ -60,8 +88,9 @@ func TestGenerate_WithConfig(t *testing.T) {
 			args := []string{
 				"--config", testCase.configPath,
 				"--output", tempProviderSpecPath,
-				testCase.oasSpecPath,
 			}
+			args = append(args, testCase.additionalArgs...)
+			args = append(args, testCase.oasSpecPath)
 
 			exitCode := c.Run(args)
 			if exitCode != 0 {
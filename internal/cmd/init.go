@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+
+	"github.com/hashicorp/cli"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"gopkg.in/yaml.v3"
+)
+
+// initConfigHeader is the comment at the top of a generator config scaffolded by the init command.
+const initConfigHeader = `Generator config scaffolded by "tfplugingen-openapi init".
+
+Resources and data sources were detected by grouping operations on a collection path (/pets) with operations on an
+identity path that ends with a parameter (/pets/{id}). Review each one before generating a provider code spec, then
+refer to the generator config documentation to add ignores, overrides, and aliases:
+https://developer.hashicorp.com/terraform/plugin/code-generation/openapi-generator#generator-config`
+
+type InitCommand struct {
+	specFlags
+
+	UI               cli.Ui
+	flagOutputPath   string
+	flagProviderName string
+	flagForce        bool
+}
+
+func (cmd *InitCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("init", flag.ExitOnError)
+	fs.StringVar(&cmd.flagOutputPath, "output", "./generator_config.yml", "destination file path for scaffolded generator config (YAML)")
+	fs.StringVar(&cmd.flagProviderName, "provider-name", "", "name of the provider (defaults to the title of the OpenAPI spec)")
+	fs.BoolVar(&cmd.flagForce, "force", false, "overwrite the generator config if it already exists")
+	cmd.addFlags(fs)
+	return fs
+}
+
+func (cmd *InitCommand) Help() string {
+	return flagsHelp("init [<args>] </path/to/oas_file.yml> [</path/to/oas_file_or_directory> ...]", cmd.Flags())
+}
+
+func (cmd *InitCommand) Synopsis() string {
+	return "Scaffolds a generator config from an OpenAPI 3.x Specification"
+}
+
+func (cmd *InitCommand) Run(args []string) int {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		logger.Error("error parsing flags", "err", err)
+		return 1
+	}
+
+	cmd.oasInputPaths = fs.Args()
+	if len(cmd.oasInputPaths) == 0 {
+		logger.Error("error executing command", "err", "at least one OpenAPI specification file or directory is required as last argument(s)")
+		return 1
+	}
+
+	err = cmd.runInternal(logger)
+	if err != nil {
+		logger.Error("error executing command", "err", err)
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *InitCommand) runInternal(logger *slog.Logger) error {
+	if !cmd.flagForce {
+		_, err := os.Stat(cmd.flagOutputPath)
+		if err == nil {
+			return fmt.Errorf("generator config '%s' already exists, use --force to overwrite it", cmd.flagOutputPath)
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return fmt.Errorf("error checking generator config file: %w", err)
+		}
+	}
+
+	// 1. Read and parse OpenAPI spec files, then build out the OpenAPI model
+	model, err := cmd.buildModel(logger, config.Config{})
+	if err != nil {
+		return err
+	}
+
+	// 2. Guess all resources and data sources from the OpenAPI spec paths
+	cfg := explorer.GuessConfig(model)
+	if cmd.flagProviderName != "" {
+		cfg.Provider.Name = cmd.flagProviderName
+	}
+
+	if len(cfg.Resources) == 0 && len(cfg.DataSources) == 0 {
+		return errors.New("no resources or data sources found in OpenAPI spec")
+	}
+
+	// 3. Marshal the generator config with comments, and validate that the result can be parsed
+	configBytes, err := marshalInitConfig(cfg, model)
+	if err != nil {
+		return err
+	}
+
+	_, err = config.ParseConfig(configBytes)
+	if err != nil {
+		return fmt.Errorf("error parsing scaffolded generator config: %w", err)
+	}
+
+	// 4. Output to generator config file
+	err = os.WriteFile(cmd.flagOutputPath, configBytes, 0644)
+	if err != nil {
+		return fmt.Errorf("error writing generator config to output: %w", err)
+	}
+
+	cmd.UI.Output(fmt.Sprintf("Wrote generator config with %d resource(s) and %d data source(s) to '%s'.",
+		len(cfg.Resources), len(cfg.DataSources), cmd.flagOutputPath))
+
+	return nil
+}
+
+// marshalInitConfig marshals a guessed generator config to YAML, commenting each operation with its summary from the OpenAPI spec.
+// Resource and data source names are converted to valid Terraform identifiers, as they are derived from the OpenAPI spec paths.
+func marshalInitConfig(cfg config.Config, spec high.Document) ([]byte, error) {
+	providerNode := newMappingNode()
+	setMappingValue(providerNode, "name", newScalarNode(cfg.Provider.Name))
+	if cfg.Provider.SchemaRef != "" {
+		setMappingValue(providerNode, "schema_ref", newScalarNode(cfg.Provider.SchemaRef))
+	}
+
+	resourcesNode := newMappingNode()
+	for _, name := range util.SortedKeys(cfg.Resources) {
+		resource := cfg.Resources[name]
+
+		resourceNode := newMappingNode()
+		setOperationLocation(resourceNode, "create", resource.Create, spec)
+		setOperationLocation(resourceNode, "read", resource.Read, spec)
+		setOperationLocation(resourceNode, "update", resource.Update, spec)
+		setOperationLocation(resourceNode, "delete", resource.Delete, spec)
+		setMappingValue(resourcesNode, util.TerraformIdentifier(name), resourceNode)
+
+		if resource.Update == nil {
+			resourcesNode.Content[len(resourcesNode.Content)-2].HeadComment = "No PUT or PATCH operation found, add an update operation if this resource can be updated in place"
+		}
+	}
+
+	dataSourcesNode := newMappingNode()
+	for _, name := range util.SortedKeys(cfg.DataSources) {
+		dataSourceNode := newMappingNode()
+		setOperationLocation(dataSourceNode, "read", cfg.DataSources[name].Read, spec)
+		setMappingValue(dataSourcesNode, util.TerraformIdentifier(name), dataSourceNode)
+	}
+
+	root := newMappingNode()
+	setMappingValue(root, "provider", providerNode)
+	if cfg.Provider.SchemaRef == "" {
+		root.Content[0].HeadComment = "No provider schema found, set schema_ref to a schema in components/schemas to add provider configuration"
+	}
+	if len(resourcesNode.Content) > 0 {
+		setMappingValue(root, "resources", resourcesNode)
+	}
+	if len(dataSourcesNode.Content) > 0 {
+		setMappingValue(root, "data_sources", dataSourcesNode)
+	}
+
+	doc := &yaml.Node{
+		Kind:        yaml.DocumentNode,
+		HeadComment: initConfigHeader,
+		Content:     []*yaml.Node{root},
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+	err := encoder.Encode(doc)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling generator config: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// setOperationLocation sets the path and method of an operation in a resource or data source, with the summary of the operation
+// as a comment. Nothing is set if the location is nil.
+func setOperationLocation(node *yaml.Node, key string, location *config.OpenApiSpecLocation, spec high.Document) {
+	if location == nil {
+		return
+	}
+
+	locationNode := newMappingNode()
+	setMappingValue(locationNode, "path", newScalarNode(location.Path))
+	setMappingValue(locationNode, "method", newScalarNode(location.Method))
+	setMappingValue(node, key, locationNode)
+
+	node.Content[len(node.Content)-2].LineComment = operationSummary(spec, location)
+}
+
+// operationSummary returns the summary of the operation at the location, falling back to the operation ID.
+func operationSummary(spec high.Document, location *config.OpenApiSpecLocation) string {
+	if spec.Paths == nil {
+		return ""
+	}
+
+	pathItem, ok := spec.Paths.PathItems.Get(location.Path)
+	if !ok {
+		return ""
+	}
+
+	op, ok := pathItem.GetOperations().Get(strings.ToLower(location.Method))
+	if !ok {
+		return ""
+	}
+
+	if op.Summary != "" {
+		return op.Summary
+	}
+
+	return op.OperationId
+}
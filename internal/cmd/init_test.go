@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"os"
+	"path"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/cmd"
+)
+
+func TestInit(t *testing.T) {
+	t.Parallel()
+
+	tempConfigPath := path.Join(t.TempDir(), "generator_config.yml")
+
+	mockUi := cli.NewMockUi()
+	c := cmd.InitCommand{UI: mockUi}
+	args := []string{
+		"--output", tempConfigPath,
+		"testdata/petstore3/openapi_spec.json",
+	}
+
+	exitCode := c.Run(args)
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running init cmd: %s", mockUi.ErrorWriter.String())
+	}
+
+	goldenFileBytes, err := os.ReadFile("testdata/init/generator_config.yml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tempConfigBytes, err := os.ReadFile(tempConfigPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(string(tempConfigBytes), string(goldenFileBytes)); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	// The scaffolded generator config can't be overwritten without --force
+	exitCode = c.Run(args)
+	if exitCode != 1 {
+		t.Fatalf("expected init cmd to fail when generator config already exists, got exit code: %d", exitCode)
+	}
+
+	exitCode = c.Run(append([]string{"--force"}, args...))
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running init cmd with --force: %s", mockUi.ErrorWriter.String())
+	}
+}
+
+func TestInit_Generate(t *testing.T) {
+	t.Parallel()
+
+	// The scaffolded generator config should be valid for generating a provider code spec
+	mockUi := cli.NewMockUi()
+	c := cmd.GenerateCommand{UI: mockUi}
+	args := []string{
+		"--config", "testdata/init/generator_config.yml",
+		"--output", path.Join(t.TempDir(), "provider_code_spec.json"),
+		"testdata/petstore3/openapi_spec.json",
+	}
+
+	exitCode := c.Run(args)
+	if exitCode != 0 {
+		t.Fatalf("unexpected error running generate cmd: %s", mockUi.ErrorWriter.String())
+	}
+}
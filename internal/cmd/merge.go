@@ -0,0 +1,429 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+)
+
+// mergedSpecFileName is the file name of the merged OpenAPI spec, located in the base path. The merged OpenAPI spec is never written,
+// but relative file references are resolved from this location.
+const mergedSpecFileName = "openapi_spec.merged.yml"
+
+// Operation keys that are valid in an OpenAPI 3.x path item.
+var pathItemOperationKeys = append(slices.Clone(swaggerOperationKeys), "trace")
+
+// specDocument is a single OpenAPI spec document, which can be merged with other documents.
+type specDocument struct {
+	// Name is the file name of the document, without the extension. It's used to reference the document in the generator config.
+	name string
+	path string
+	root *yaml.Node
+}
+
+// findSpecFiles returns all OpenAPI spec files from the input paths. If an input path is a directory, all JSON and YAML files
+// directly in the directory are returned, sorted by file name.
+func findSpecFiles(inputPaths []string) ([]string, error) {
+	specPaths := []string{}
+
+	for _, inputPath := range inputPaths {
+		info, err := os.Stat(inputPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading OpenAPI spec file: %w", err)
+		}
+
+		if !info.IsDir() {
+			specPaths = append(specPaths, inputPath)
+			continue
+		}
+
+		entries, err := os.ReadDir(inputPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading OpenAPI spec directory: %w", err)
+		}
+
+		foundSpec := false
+		for _, entry := range entries {
+			if entry.IsDir() || !slices.Contains([]string{".json", ".yml", ".yaml"}, filepath.Ext(entry.Name())) {
+				continue
+			}
+			specPaths = append(specPaths, filepath.Join(inputPath, entry.Name()))
+			foundSpec = true
+		}
+
+		if !foundSpec {
+			return nil, fmt.Errorf("no OpenAPI spec files (JSON or YAML) found in directory '%s'", inputPath)
+		}
+	}
+
+	return specPaths, nil
+}
+
+// parseSpecDocuments parses each OpenAPI spec into a document, named after the spec file. All document names must be unique.
+func parseSpecDocuments(specPaths []string, oasDocuments [][]byte) ([]specDocument, error) {
+	documents := make([]specDocument, 0, len(specPaths))
+	documentPaths := map[string]string{}
+
+	for i, specPath := range specPaths {
+		name := strings.TrimSuffix(filepath.Base(specPath), filepath.Ext(specPath))
+		if existingPath, ok := documentPaths[name]; ok {
+			return nil, fmt.Errorf("OpenAPI spec files '%s' and '%s' have the same document name '%s', document names must be unique", existingPath, specPath, name)
+		}
+		documentPaths[name] = specPath
+
+		var root yaml.Node
+		err := yaml.Unmarshal(oasDocuments[i], &root)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing OpenAPI spec file '%s': %w", specPath, err)
+		}
+		if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+			return nil, fmt.Errorf("error parsing OpenAPI spec file '%s': document must be an object", specPath)
+		}
+
+		documents = append(documents, specDocument{
+			name: name,
+			path: specPath,
+			root: root.Content[0],
+		})
+	}
+
+	return documents, nil
+}
+
+// hasOperation returns true if the document defines the operation, located by path and method, or operation ID.
+func (d specDocument) hasOperation(location config.OpenApiSpecLocation) bool {
+	paths := mappingValue(d.root, "paths")
+
+	if location.OperationId == "" {
+		return mappingValue(mappingValue(paths, location.Path), strings.ToLower(location.Method)) != nil
+	}
+
+	if paths == nil {
+		return false
+	}
+
+	for i := 0; i+1 < len(paths.Content); i += 2 {
+		for _, key := range pathItemOperationKeys {
+			if scalarValue(mappingValue(mappingValue(paths.Content[i+1], key), "operationId")) == location.OperationId {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// checkDocumentLocations verifies that every operation location in the generator config that names a document is defined in that document.
+func checkDocumentLocations(cfg config.Config, documents []specDocument) error {
+	documentsByName := map[string]specDocument{}
+	documentNames := []string{}
+	for _, document := range documents {
+		documentsByName[document.name] = document
+		documentNames = append(documentNames, document.name)
+	}
+
+	checkLocation := func(location *config.OpenApiSpecLocation) error {
+		if location == nil || location.Document == "" {
+			return nil
+		}
+
+		document, ok := documentsByName[location.Document]
+		if !ok {
+			return fmt.Errorf("document '%s' not found, available documents: %s", location.Document, strings.Join(documentNames, ", "))
+		}
+
+		if !document.hasOperation(*location) {
+			if location.OperationId != "" {
+				return fmt.Errorf("operation_id '%s' not found in document '%s'", location.OperationId, location.Document)
+			}
+			return fmt.Errorf("operation '%s %s' not found in document '%s'", strings.ToUpper(location.Method), location.Path, location.Document)
+		}
+
+		return nil
+	}
+
+	var errResult error
+
+	resourceNames := make([]string, 0, len(cfg.Resources))
+	for name := range cfg.Resources {
+		resourceNames = append(resourceNames, name)
+	}
+	sort.Strings(resourceNames)
+
+	for _, name := range resourceNames {
+		resource := cfg.Resources[name]
+		for _, op := range []struct {
+			name     string
+			location *config.OpenApiSpecLocation
+		}{
+			{"create", resource.Create},
+			{"read", resource.Read},
+			{"update", resource.Update},
+			{"delete", resource.Delete},
+		} {
+			if err := checkLocation(op.location); err != nil {
+				errResult = errors.Join(errResult, fmt.Errorf("resource '%s' %s: %w", name, op.name, err))
+			}
+		}
+	}
+
+	dataSourceNames := make([]string, 0, len(cfg.DataSources))
+	for name := range cfg.DataSources {
+		dataSourceNames = append(dataSourceNames, name)
+	}
+	sort.Strings(dataSourceNames)
+
+	for _, name := range dataSourceNames {
+		if err := checkLocation(cfg.DataSources[name].Read); err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("data_source '%s' read: %w", name, err))
+		}
+	}
+
+	return errResult
+}
+
+// commonDirectory returns the deepest directory that contains all of the OpenAPI spec files.
+func commonDirectory(specPaths []string) (string, error) {
+	common := ""
+	for _, specPath := range specPaths {
+		absPath, err := filepath.Abs(specPath)
+		if err != nil {
+			return "", fmt.Errorf("error determining absolute path of OpenAPI spec file '%s': %w", specPath, err)
+		}
+
+		dir := filepath.Dir(absPath)
+		if common == "" {
+			common = dir
+			continue
+		}
+
+		for !isPathInRoot(dir, common) {
+			common = filepath.Dir(common)
+		}
+	}
+
+	return common, nil
+}
+
+// mergeSpecDocuments merges all OpenAPI spec documents into a single OpenAPI spec, returned as YAML. Top-level fields, like info, are
+// taken from the first document that defines them. Paths and components are merged, with an error returned for:
+//   - Operations with the same path and method in multiple documents
+//   - Path item fields, like parameters, defined differently in multiple documents
+//   - Components with the same name defined differently in multiple documents
+//
+// Relative file references in each document are updated to be relative to the base path. If a document defines different servers
+// than the merged OpenAPI spec, the servers are added to each operation in that document.
+func mergeSpecDocuments(documents []specDocument, basePath string) ([]byte, error) {
+	merged := newMappingNode()
+	paths := newMappingNode()
+	components := newMappingNode()
+	tagNames := []string{}
+
+	// The document each path item field, operation, and component was first defined in, used for conflict errors
+	origins := map[string]string{}
+
+	var errResult error
+	for _, document := range documents {
+		docDir, err := filepath.Abs(filepath.Dir(document.path))
+		if err != nil {
+			return nil, fmt.Errorf("error determining absolute path of OpenAPI spec file '%s': %w", document.path, err)
+		}
+		rewriteFileReferences(document.root, docDir, basePath)
+
+		if servers := mappingValue(document.root, "servers"); servers != nil && mappingValue(merged, "servers") != nil && !nodesEqual(servers, mappingValue(merged, "servers")) {
+			addOperationServers(document.root, servers)
+		}
+
+		for i := 0; i+1 < len(document.root.Content); i += 2 {
+			key, value := document.root.Content[i].Value, document.root.Content[i+1]
+
+			switch key {
+			case "paths":
+				if mappingValue(merged, "paths") == nil {
+					setMappingValue(merged, "paths", paths)
+				}
+				errResult = errors.Join(errResult, mergePaths(paths, value, document.name, origins))
+			case "components":
+				if mappingValue(merged, "components") == nil {
+					setMappingValue(merged, "components", components)
+				}
+				errResult = errors.Join(errResult, mergeComponents(components, value, document.name, origins))
+			case "tags":
+				if mappingValue(merged, "tags") == nil {
+					setMappingValue(merged, "tags", &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"})
+				}
+				tags := mappingValue(merged, "tags")
+				for _, tag := range value.Content {
+					name := scalarValue(mappingValue(tag, "name"))
+					if !slices.Contains(tagNames, name) {
+						tagNames = append(tagNames, name)
+						tags.Content = append(tags.Content, tag)
+					}
+				}
+			default:
+				if mappingValue(merged, key) == nil {
+					setMappingValue(merged, key, value)
+				}
+			}
+		}
+	}
+
+	if errResult != nil {
+		return nil, fmt.Errorf("error merging OpenAPI spec files:\n%w", errResult)
+	}
+
+	bytes, err := yaml.Marshal(merged)
+	if err != nil {
+		return nil, fmt.Errorf("error marshalling merged OpenAPI spec: %w", err)
+	}
+
+	return bytes, nil
+}
+
+func mergePaths(merged *yaml.Node, paths *yaml.Node, documentName string, origins map[string]string) error {
+	var errResult error
+
+	for i := 0; i+1 < len(paths.Content); i += 2 {
+		path, pathItem := paths.Content[i].Value, paths.Content[i+1]
+
+		mergedPathItem := mappingValue(merged, path)
+		if mergedPathItem == nil {
+			mergedPathItem = newMappingNode()
+			setMappingValue(merged, path, mergedPathItem)
+		}
+
+		for j := 0; j+1 < len(pathItem.Content); j += 2 {
+			key, value := pathItem.Content[j].Value, pathItem.Content[j+1]
+			originKey := fmt.Sprintf("paths/%s/%s", path, key)
+
+			existing := mappingValue(mergedPathItem, key)
+			if existing == nil {
+				setMappingValue(mergedPathItem, key, value)
+				origins[originKey] = documentName
+				continue
+			}
+
+			if slices.Contains(pathItemOperationKeys, key) {
+				errResult = errors.Join(errResult, fmt.Errorf("\toperation '%s %s' is defined in both document '%s' and '%s'", strings.ToUpper(key), path, origins[originKey], documentName))
+				continue
+			}
+
+			if !nodesEqual(existing, value) {
+				errResult = errors.Join(errResult, fmt.Errorf("\tpath '%s' field '%s' is defined differently in document '%s' and '%s'", path, key, origins[originKey], documentName))
+			}
+		}
+	}
+
+	return errResult
+}
+
+func mergeComponents(merged *yaml.Node, components *yaml.Node, documentName string, origins map[string]string) error {
+	var errResult error
+
+	for i := 0; i+1 < len(components.Content); i += 2 {
+		componentType, componentMap := components.Content[i].Value, components.Content[i+1]
+
+		mergedComponentMap := mappingValue(merged, componentType)
+		if mergedComponentMap == nil {
+			mergedComponentMap = newMappingNode()
+			setMappingValue(merged, componentType, mergedComponentMap)
+		}
+
+		for j := 0; j+1 < len(componentMap.Content); j += 2 {
+			name, value := componentMap.Content[j].Value, componentMap.Content[j+1]
+			originKey := fmt.Sprintf("components/%s/%s", componentType, name)
+
+			existing := mappingValue(mergedComponentMap, name)
+			if existing == nil {
+				setMappingValue(mergedComponentMap, name, value)
+				origins[originKey] = documentName
+				continue
+			}
+
+			// Identical components, like a shared error schema, are allowed in multiple documents
+			if !nodesEqual(existing, value) {
+				errResult = errors.Join(errResult, fmt.Errorf("\tcomponent '%s' is defined differently in document '%s' and '%s'", originKey, origins[originKey], documentName))
+			}
+		}
+	}
+
+	return errResult
+}
+
+// addOperationServers adds the servers to every operation in the document that doesn't define its own servers.
+func addOperationServers(doc *yaml.Node, servers *yaml.Node) {
+	paths := mappingValue(doc, "paths")
+	if paths == nil {
+		return
+	}
+
+	for i := 0; i+1 < len(paths.Content); i += 2 {
+		pathItem := paths.Content[i+1]
+		if mappingValue(pathItem, "servers") != nil {
+			continue
+		}
+
+		for _, key := range pathItemOperationKeys {
+			operation := mappingValue(pathItem, key)
+			if operation != nil && mappingValue(operation, "servers") == nil {
+				setMappingValue(operation, "servers", servers)
+			}
+		}
+	}
+}
+
+// rewriteFileReferences updates all relative file references in the node to be relative to the base path, rather than the directory
+// of the document.
+func rewriteFileReferences(node *yaml.Node, docDir string, basePath string) {
+	switch node.Kind {
+	case yaml.SequenceNode:
+		for _, child := range node.Content {
+			rewriteFileReferences(child, docDir, basePath)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value != "$ref" || value.Kind != yaml.ScalarNode {
+				rewriteFileReferences(value, docDir, basePath)
+				continue
+			}
+
+			file, pointer, hasPointer := strings.Cut(value.Value, "#")
+			if file == "" || strings.Contains(file, "://") || filepath.IsAbs(file) {
+				continue
+			}
+
+			relPath, err := filepath.Rel(basePath, filepath.Join(docDir, filepath.FromSlash(file)))
+			if err != nil {
+				continue
+			}
+
+			value.Value = filepath.ToSlash(relPath)
+			if hasPointer {
+				value.Value += "#" + pointer
+			}
+		}
+	}
+}
+
+// nodesEqual returns true if both nodes decode to the same value, ignoring formatting differences like quoting or key order.
+func nodesEqual(a *yaml.Node, b *yaml.Node) bool {
+	var aValue, bValue any
+	if a.Decode(&aValue) != nil || b.Decode(&bValue) != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(aValue, bValue)
+}
@@ -0,0 +1,242 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"path/filepath"
+	"regexp"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+)
+
+func TestMergeSpecDocuments(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		specs            map[string]string
+		expectedOASSpec  string
+		expectedErrRegex string
+	}{
+		"merge paths and components": {
+			specs: map[string]string{
+				"specs/things.yml": `openapi: 3.0.3
+info:
+  title: Things
+servers:
+  - url: https://things.example.com
+paths:
+  /things:
+    get:
+      operationId: listThings
+components:
+  schemas:
+    error:
+      type: object
+    thing:
+      $ref: "./schemas/thing.yml"
+`,
+				"specs/other/widgets.yml": `openapi: 3.0.3
+info:
+  title: Widgets
+servers:
+  - url: https://widgets.example.com
+paths:
+  /things:
+    post:
+      operationId: createThing
+  /widgets:
+    get:
+      operationId: listWidgets
+components:
+  schemas:
+    error:
+      type: "object"
+    widget:
+      $ref: "../schemas/widget.yml#/widget"
+`,
+			},
+			expectedOASSpec: `openapi: 3.0.3
+info:
+    title: Things
+servers:
+    - url: https://things.example.com
+paths:
+    /things:
+        get:
+            operationId: listThings
+        post:
+            operationId: createThing
+            servers:
+                - url: https://widgets.example.com
+    /widgets:
+        get:
+            operationId: listWidgets
+            servers:
+                - url: https://widgets.example.com
+components:
+    schemas:
+        error:
+            type: object
+        thing:
+            $ref: "specs/schemas/thing.yml"
+        widget:
+            $ref: "specs/schemas/widget.yml#/widget"
+`,
+		},
+		"conflicting operations and components": {
+			specs: map[string]string{
+				"specs/things.yml": `openapi: 3.0.3
+paths:
+  /things:
+    get:
+      operationId: listThings
+components:
+  schemas:
+    thing:
+      type: object
+`,
+				"specs/other/widgets.yml": `openapi: 3.0.3
+paths:
+  /things:
+    get:
+      operationId: listWidgets
+components:
+  schemas:
+    thing:
+      type: string
+`,
+			},
+			expectedErrRegex: `operation 'GET /things' is defined in both document 'things' and 'widgets'(.|\n)*component 'components/schemas/thing' is defined differently in document 'things' and 'widgets'`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			basePath := t.TempDir()
+
+			// The first document is always things.yml, so top-level fields are taken from it
+			specPaths := []string{filepath.Join(basePath, "specs/things.yml"), filepath.Join(basePath, "specs/other/widgets.yml")}
+			oasDocuments := [][]byte{[]byte(testCase.specs["specs/things.yml"]), []byte(testCase.specs["specs/other/widgets.yml"])}
+
+			documents, err := parseSpecDocuments(specPaths, oasDocuments)
+			if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			got, err := mergeSpecDocuments(documents, basePath)
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedErrRegex)
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %s", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expectedOASSpec); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestCheckDocumentLocations(t *testing.T) {
+	t.Parallel()
+
+	documents, err := parseSpecDocuments(
+		[]string{"specs/things.yml", "specs/widgets.json"},
+		[][]byte{
+			[]byte(`{"paths": {"/things": {"get": {"operationId": "listThings"}}}}`),
+			[]byte(`{"paths": {"/widgets": {"get": {}}}}`),
+		},
+	)
+	if err != nil {
+		t.Fatalf("was not expecting error, got: %s", err)
+	}
+
+	testCases := map[string]struct {
+		cfg              config.Config
+		expectedErrRegex string
+	}{
+		"valid": {
+			cfg: config.Config{
+				Resources: map[string]config.Resource{
+					"thing": {
+						Read: &config.OpenApiSpecLocation{Path: "/things", Method: "GET", Document: "things"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"thing": {
+						Read: &config.OpenApiSpecLocation{OperationId: "listThings", Document: "things"},
+					},
+					"widget": {
+						Read: &config.OpenApiSpecLocation{Path: "/widgets", Method: "get"},
+					},
+				},
+			},
+		},
+		"operation_id not in document": {
+			cfg: config.Config{
+				DataSources: map[string]config.DataSource{
+					"thing": {
+						Read: &config.OpenApiSpecLocation{OperationId: "listThings", Document: "widgets"},
+					},
+				},
+			},
+			expectedErrRegex: `data_source 'thing' read: operation_id 'listThings' not found in document 'widgets'`,
+		},
+		"document not found": {
+			cfg: config.Config{
+				DataSources: map[string]config.DataSource{
+					"widget": {
+						Read: &config.OpenApiSpecLocation{Path: "/widgets", Method: "GET", Document: "gadgets"},
+					},
+				},
+			},
+			expectedErrRegex: `data_source 'widget' read: document 'gadgets' not found, available documents: things, widgets`,
+		},
+		"operation not in document": {
+			cfg: config.Config{
+				Resources: map[string]config.Resource{
+					"widget": {
+						Create: &config.OpenApiSpecLocation{Path: "/widgets", Method: "POST", Document: "widgets"},
+						Read:   &config.OpenApiSpecLocation{Path: "/widgets", Method: "GET", Document: "things"},
+					},
+				},
+			},
+			expectedErrRegex: `resource 'widget' create: operation 'POST /widgets' not found in document 'widgets'\nresource 'widget' read: operation 'GET /widgets' not found in document 'things'`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := checkDocumentLocations(testCase.cfg, documents)
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedErrRegex)
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %s", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/speakeasy-api/jsonpath/pkg/jsonpath"
+	"github.com/speakeasy-api/jsonpath/pkg/jsonpath/config"
+	"github.com/speakeasy-api/jsonpath/pkg/overlay"
+	"gopkg.in/yaml.v3"
+)
+
+// applyOverlays parses each OpenAPI spec and applies each [OpenAPI Overlay] document to them, in the order provided. Every overlay
+// action is applied to all OpenAPI specs its target matches. The resulting OpenAPI specs are returned as YAML. A warning is logged
+// for every overlay action with a target that doesn't match any nodes in any of the OpenAPI specs.
+//
+// The OpenAPI specs are re-serialized when overlays are applied, so line numbers in later errors refer to the OpenAPI specs with
+// overlays applied, not the original files. Without overlays, the OpenAPI specs are returned unchanged.
+//
+// [OpenAPI Overlay]: https://spec.openapis.org/overlay/v1.0.0.html
+func applyOverlays(logger *slog.Logger, oasDocuments [][]byte, overlayPaths []string) ([][]byte, error) {
+	if len(overlayPaths) == 0 {
+		return oasDocuments, nil
+	}
+
+	roots := make([]*yaml.Node, len(oasDocuments))
+	for i, oasBytes := range oasDocuments {
+		var root yaml.Node
+		err := yaml.Unmarshal(oasBytes, &root)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing OpenAPI spec file: %w", err)
+		}
+		roots[i] = &root
+	}
+
+	for _, overlayPath := range overlayPaths {
+		overlayDoc, err := overlay.Parse(overlayPath)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing overlay file '%s': %w", overlayPath, err)
+		}
+
+		err = overlayDoc.Validate()
+		if err != nil {
+			return nil, fmt.Errorf("error validating overlay file '%s': %w", overlayPath, err)
+		}
+
+		for i, action := range overlayDoc.Actions {
+			target, err := jsonpath.NewPath(action.Target, config.WithPropertyNameExtension())
+			if err != nil {
+				return nil, fmt.Errorf("error parsing target for overlay file '%s' action at index %d: %w", overlayPath, i, err)
+			}
+
+			matched := false
+			for _, root := range roots {
+				if len(target.Query(root)) == 0 {
+					continue
+				}
+				matched = true
+
+				// Actions are applied one at a time, so each target is matched against the result of the previous actions
+				actionOverlay := overlay.Overlay{Actions: []overlay.Action{action}}
+				err = actionOverlay.ApplyTo(root)
+				if err != nil {
+					return nil, fmt.Errorf("error applying overlay file '%s' action at index %d: %w", overlayPath, i, err)
+				}
+			}
+
+			if !matched {
+				logger.Warn(
+					"overlay action target did not match any nodes in OpenAPI spec",
+					"overlay", overlayPath,
+					"target", action.Target)
+			}
+		}
+	}
+
+	result := make([][]byte, len(roots))
+	for i, root := range roots {
+		bytes, err := yaml.Marshal(root)
+		if err != nil {
+			return nil, fmt.Errorf("error marshalling OpenAPI spec with overlays applied: %w", err)
+		}
+		result[i] = bytes
+	}
+
+	// Logged as a warning, so it's shown with the default log level of the commands
+	logger.Warn(
+		"applied overlays to OpenAPI spec, line numbers in OpenAPI spec errors refer to the spec with overlays applied",
+		"overlays", len(overlayPaths))
+
+	return result, nil
+}
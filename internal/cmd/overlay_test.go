@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestApplyOverlays(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpec          string
+		overlays         []string
+		expectedOASSpec  string
+		expectedWarnings []string
+		expectedErrRegex string
+	}{
+		"update and remove actions": {
+			oasSpec: `openapi: 3.1.0
+components:
+  schemas:
+    thing:
+      type: object
+      properties:
+        name:
+          type: string
+        secret:
+          type: string
+`,
+			overlays: []string{`overlay: 1.0.0
+info:
+  title: Overlay
+  version: 1.0.0
+actions:
+  - target: $.components.schemas.thing
+    update:
+      required:
+        - name
+  - target: $.components.schemas.thing.properties.secret
+    remove: true
+`},
+			expectedOASSpec: `openapi: 3.1.0
+components:
+    schemas:
+        thing:
+            type: object
+            properties:
+                name:
+                    type: string
+            required:
+                - name
+`,
+			expectedWarnings: []string{"line numbers in OpenAPI spec errors refer to the spec with overlays applied"},
+		},
+		"no overlays": {
+			oasSpec: `openapi: 3.1.0
+components:
+  schemas:
+    thing:
+      type: string
+`,
+			expectedOASSpec: `openapi: 3.1.0
+components:
+  schemas:
+    thing:
+      type: string
+`,
+		},
+		"multiple overlays are applied in order": {
+			oasSpec: `openapi: 3.1.0
+components:
+  schemas:
+    thing:
+      type: string
+`,
+			overlays: []string{`overlay: 1.0.0
+info:
+  title: First overlay
+  version: 1.0.0
+actions:
+  - target: $.components.schemas.thing
+    update:
+      type: integer
+`, `overlay: 1.0.0
+info:
+  title: Second overlay
+  version: 1.0.0
+actions:
+  - target: $.components.schemas.thing
+    update:
+      type: number
+`},
+			expectedOASSpec: `openapi: 3.1.0
+components:
+    schemas:
+        thing:
+            type: number
+`,
+		},
+		"target without matches": {
+			oasSpec: `openapi: 3.1.0
+components:
+  schemas:
+    thing:
+      type: string
+`,
+			overlays: []string{`overlay: 1.0.0
+info:
+  title: Overlay
+  version: 1.0.0
+actions:
+  - target: $.components.schemas.not_a_thing
+    remove: true
+`},
+			expectedOASSpec: `openapi: 3.1.0
+components:
+    schemas:
+        thing:
+            type: string
+`,
+			expectedWarnings: []string{"$.components.schemas.not_a_thing"},
+		},
+		"invalid overlay": {
+			oasSpec: `openapi: 3.1.0`,
+			overlays: []string{`overlay: 1.0.0
+info:
+  title: Overlay
+  version: 1.0.0
+actions: []
+`},
+			expectedErrRegex: `overlay must define at least one action`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			overlayPaths := []string{}
+			for i, overlay := range testCase.overlays {
+				overlayPath := path.Join(t.TempDir(), fmt.Sprintf("overlay_%d.yml", i))
+				err := os.WriteFile(overlayPath, []byte(overlay), 0600)
+				if err != nil {
+					t.Fatal(err)
+				}
+				overlayPaths = append(overlayPaths, overlayPath)
+			}
+
+			logs := &bytes.Buffer{}
+			logger := slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{
+				Level: slog.LevelWarn,
+			}))
+
+			got, err := applyOverlays(logger, [][]byte{[]byte(testCase.oasSpec)}, overlayPaths)
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedErrRegex)
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %s", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			if diff := cmp.Diff(string(got[0]), testCase.expectedOASSpec); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			for _, expectedWarning := range testCase.expectedWarnings {
+				if !strings.Contains(logs.String(), expectedWarning) {
+					t.Errorf("expected warning containing %q, got logs: %s", expectedWarning, logs.String())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel"
+	"github.com/pb33f/libopenapi/index"
+	"gopkg.in/yaml.v3"
+)
+
+// newDocumentConfiguration returns a libopenapi document configuration that will resolve relative file references in the OpenAPI
+// spec. If basePath is empty, relative references are resolved against the directory of the OpenAPI spec file.
+func newDocumentConfiguration(oasInputPath string, basePath string) (*datamodel.DocumentConfiguration, error) {
+	if basePath == "" {
+		basePath = filepath.Dir(oasInputPath)
+	}
+
+	absBasePath, err := filepath.Abs(basePath)
+	if err != nil {
+		return nil, fmt.Errorf("error determining absolute base path: %w", err)
+	}
+
+	docConfig := datamodel.NewDocumentConfiguration()
+	docConfig.BasePath = absBasePath
+	docConfig.SpecFilePath = filepath.Base(oasInputPath)
+	docConfig.AllowFileReferences = true
+	// Resolution errors are reported with the referencing file and line by checkFileReferences
+	docConfig.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	return docConfig, nil
+}
+
+// checkFileReferenceRoots verifies that all relative file references, starting from the OpenAPI spec and following references into
+// other files, are located inside of the base path or one of the allowed roots. Files are only read if they're inside of the allowed
+// roots, so this must be called before building the OpenAPI model, as libopenapi reads every referenced file. All errors include the
+// referencing file and line.
+func checkFileReferenceRoots(oasBytes []byte, oasInputPath string, basePath string, allowedRoots []string) error {
+	roots := []string{basePath}
+	for _, allowedRoot := range allowedRoots {
+		absRoot, err := filepath.Abs(allowedRoot)
+		if err != nil {
+			return fmt.Errorf("error determining absolute path of allowed reference root '%s': %w", allowedRoot, err)
+		}
+		roots = append(roots, absRoot)
+	}
+
+	// Relative references in the OpenAPI spec are resolved against the base path, and relative references in other files are
+	// resolved against the directory of that file, like libopenapi does
+	rootSpecPath := filepath.Join(basePath, filepath.Base(oasInputPath))
+	visited := map[string]bool{rootSpecPath: true}
+	pending := []string{rootSpecPath}
+
+	var errResult error
+	for len(pending) > 0 {
+		specPath := pending[0]
+		pending = pending[1:]
+
+		specBytes := oasBytes
+		displayPath := oasInputPath
+		if specPath != rootSpecPath {
+			var err error
+			specBytes, err = os.ReadFile(specPath)
+			if err != nil {
+				// Missing files are reported by checkFileReferences once the model is built
+				continue
+			}
+			displayPath = specPath
+		}
+
+		var root yaml.Node
+		if err := yaml.Unmarshal(specBytes, &root); err != nil {
+			// Invalid files are reported when building the model
+			continue
+		}
+
+		for _, refNode := range findReferenceNodes(&root) {
+			refFile, _, _ := strings.Cut(refNode.Value, "#")
+			if refFile == "" || isRemoteReference(refFile) {
+				continue
+			}
+
+			refPath := refFile
+			if !filepath.IsAbs(refPath) {
+				refPath = filepath.Join(filepath.Dir(specPath), refPath)
+			}
+
+			if !slices.ContainsFunc(roots, func(root string) bool { return isPathInRoot(refPath, root) }) {
+				location := fmt.Sprintf("%s:%d", displayPath, refNode.Line)
+				errResult = errors.Join(errResult, fmt.Errorf("%s: reference '%s' resolves to '%s', which is outside of the base path and allowed reference roots", location, refNode.Value, refPath))
+				continue
+			}
+
+			if !visited[refPath] {
+				visited[refPath] = true
+				pending = append(pending, refPath)
+			}
+		}
+	}
+
+	return errResult
+}
+
+// findReferenceNodes returns the value nodes of every `$ref` in a YAML or JSON document.
+func findReferenceNodes(node *yaml.Node) []*yaml.Node {
+	refNodes := []*yaml.Node{}
+
+	if node.Kind == yaml.MappingNode {
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key, value := node.Content[i], node.Content[i+1]
+			if key.Value == "$ref" && value.Kind == yaml.ScalarNode {
+				refNodes = append(refNodes, value)
+				continue
+			}
+			refNodes = append(refNodes, findReferenceNodes(value)...)
+		}
+
+		return refNodes
+	}
+
+	for _, child := range node.Content {
+		refNodes = append(refNodes, findReferenceNodes(child)...)
+	}
+
+	return refNodes
+}
+
+// isRemoteReference returns true if the file of a reference is an HTTP or HTTPS URL, which are resolved by libopenapi.
+func isRemoteReference(refFile string) bool {
+	refURL, err := url.Parse(refFile)
+	if err != nil {
+		return false
+	}
+
+	return refURL.Scheme == "http" || refURL.Scheme == "https"
+}
+
+// checkFileReferences verifies that all relative file references found while building the OpenAPI spec exist. Allowed roots
+// are checked by checkFileReferenceRoots before the model is built. All errors include the referencing file and line.
+func checkFileReferences(rolodex *index.Rolodex, oasInputPath string) error {
+	if rolodex == nil || rolodex.GetRootIndex() == nil {
+		return nil
+	}
+
+	rootSpecPath := rolodex.GetRootIndex().GetSpecAbsolutePath()
+
+	references := []*index.Reference{}
+	for _, idx := range append([]*index.SpecIndex{rolodex.GetRootIndex()}, rolodex.GetIndexes()...) {
+		if idx == nil {
+			continue
+		}
+		for _, ref := range idx.GetAllReferences() {
+			references = append(references, ref)
+		}
+	}
+
+	slices.SortFunc(references, func(a, b *index.Reference) int {
+		return cmp.Or(
+			cmp.Compare(referencingFile(a, rootSpecPath, oasInputPath), referencingFile(b, rootSpecPath, oasInputPath)),
+			cmp.Compare(referenceLine(a), referenceLine(b)),
+			cmp.Compare(a.FullDefinition, b.FullDefinition),
+		)
+	})
+
+	var errResult error
+	for _, ref := range references {
+		refFile, _, _ := strings.Cut(ref.FullDefinition, "#")
+
+		// Local and remote references are resolved by libopenapi
+		if refFile == "" || refFile == rootSpecPath || isRemoteReference(refFile) {
+			continue
+		}
+
+		if _, err := os.Stat(refFile); err != nil {
+			location := fmt.Sprintf("%s:%d", referencingFile(ref, rootSpecPath, oasInputPath), referenceLine(ref))
+			errResult = errors.Join(errResult, fmt.Errorf("%s: unable to resolve reference '%s', file '%s' not found", location, referenceValue(ref), refFile))
+		}
+	}
+
+	return errResult
+}
+
+// referencingFile returns the file that contains the reference, using the original OpenAPI spec input path for the root document.
+func referencingFile(ref *index.Reference, rootSpecPath string, oasInputPath string) string {
+	if ref.Index == nil || ref.Index.GetSpecAbsolutePath() == rootSpecPath {
+		return oasInputPath
+	}
+
+	return ref.Index.GetSpecAbsolutePath()
+}
+
+// referenceValue returns the original $ref value as written in the referencing file.
+func referenceValue(ref *index.Reference) string {
+	if ref.Node != nil {
+		for i := 0; i+1 < len(ref.Node.Content); i += 2 {
+			if ref.Node.Content[i].Value == "$ref" {
+				return ref.Node.Content[i+1].Value
+			}
+		}
+	}
+
+	return ref.Definition
+}
+
+func referenceLine(ref *index.Reference) int {
+	if ref.Node == nil {
+		return 0
+	}
+
+	return ref.Node.Line
+}
+
+// isPathInRoot returns true if the path is the root directory or is nested underneath it.
+func isPathInRoot(path string, root string) bool {
+	relPath, err := filepath.Rel(root, path)
+	if err != nil {
+		return false
+	}
+
+	return relPath != ".." && !strings.HasPrefix(relPath, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,123 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/pb33f/libopenapi"
+)
+
+func TestCheckFileReferences(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath      string
+		basePath         string
+		allowedRoots     []string
+		expectedErrRegex string
+	}{
+		"file references in allowed root": {
+			oasSpecPath:  "testdata/multifile/spec/openapi_spec.yml",
+			allowedRoots: []string{"testdata/multifile/shared"},
+		},
+		"file reference outside of base path": {
+			oasSpecPath:      "testdata/multifile/spec/openapi_spec.yml",
+			expectedErrRegex: `pet.yml:18: reference '../../shared/common.yml#/components/schemas/tag' resolves to '.*/shared/common.yml', which is outside of the base path and allowed reference roots`,
+		},
+		"file reference not found from explicit base path": {
+			oasSpecPath:      "testdata/multifile/spec/openapi_spec.yml",
+			basePath:         "testdata/multifile",
+			allowedRoots:     []string{"testdata/multifile/shared"},
+			expectedErrRegex: `testdata/multifile/spec/openapi_spec.yml:\d+: unable to resolve reference './schemas/pet.yml', file '.*/testdata/multifile/schemas/pet.yml' not found`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			oasBytes, err := os.ReadFile(testCase.oasSpecPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			docConfig, err := newDocumentConfiguration(testCase.oasSpecPath, testCase.basePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = checkFileReferenceRoots(oasBytes, testCase.oasSpecPath, docConfig.BasePath, testCase.allowedRoots)
+			if err == nil {
+				doc, docErr := libopenapi.NewDocumentWithConfiguration(oasBytes, docConfig)
+				if docErr != nil {
+					t.Fatal(docErr)
+				}
+
+				// Model building errors are expected for unresolvable references, only the file reference check is tested
+				_, _ = doc.BuildV3Model()
+
+				err = checkFileReferences(doc.GetRolodex(), testCase.oasSpecPath)
+			}
+			if testCase.expectedErrRegex == "" {
+				if err != nil {
+					t.Fatalf("was not expecting error, got: %s", err)
+				}
+				return
+			}
+
+			if err == nil {
+				t.Fatalf("expected error matching %q, got none", testCase.expectedErrRegex)
+			}
+			if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+				t.Fatalf("expected error matching %q, got: %s", testCase.expectedErrRegex, err)
+			}
+		})
+	}
+}
+
+func TestIsRemoteReference(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		refFile string
+		want    bool
+	}{
+		"https URL": {
+			refFile: "https://example.com/schemas/pet.yml",
+			want:    true,
+		},
+		"http URL": {
+			refFile: "http://example.com/schemas/pet.yml",
+			want:    true,
+		},
+		"relative file": {
+			refFile: "./schemas/pet.yml",
+			want:    false,
+		},
+		"relative file starting with http": {
+			refFile: "http_schemas/pet.yml",
+			want:    false,
+		},
+		"absolute file": {
+			refFile: "/schemas/pet.yml",
+			want:    false,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := isRemoteReference(testCase.refFile)
+			if got != testCase.want {
+				t.Fatalf("unexpected difference, got: %t, wanted: %t", got, testCase.want)
+			}
+		})
+	}
+}
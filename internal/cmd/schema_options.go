@@ -0,0 +1,115 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper"
+)
+
+// configMismatch is a schema option in the generator config that doesn't match the OpenAPI spec, with the line it's defined on.
+type configMismatch struct {
+	mapper.SchemaOptionMismatch
+
+	// Line is the line number in the generator config, or 0 if the schema option couldn't be located.
+	Line int
+}
+
+// findConfigMismatches returns every ignore, override, and alias in the generator config that doesn't match an attribute or
+// parameter mapped from the OpenAPI spec.
+func findConfigMismatches(dora explorer.Explorer, cfg config.Config, configBytes []byte) ([]configMismatch, error) {
+	resources, err := dora.FindResources()
+	if err != nil {
+		return nil, fmt.Errorf("error finding resource(s): %w", err)
+	}
+
+	dataSources, err := dora.FindDataSources()
+	if err != nil {
+		return nil, fmt.Errorf("error finding data source(s): %w", err)
+	}
+
+	provider, err := dora.FindProvider()
+	if err != nil {
+		return nil, fmt.Errorf("error finding provider: %w", err)
+	}
+
+	var root yaml.Node
+	err = yaml.Unmarshal(configBytes, &root)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing generator config file: %w", err)
+	}
+
+	var configNode *yaml.Node
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		configNode = root.Content[0]
+	}
+
+	mismatches := []configMismatch{}
+	for _, mismatch := range mapper.FindSchemaOptionMismatches(resources, dataSources, provider, cfg) {
+		mismatches = append(mismatches, configMismatch{
+			SchemaOptionMismatch: mismatch,
+			Line:                 schemaOptionLine(configNode, mismatch),
+		})
+	}
+
+	return mismatches, nil
+}
+
+// schemaOptionLine returns the line number of the schema option in the generator config, or 0 if it's not found.
+func schemaOptionLine(configNode *yaml.Node, mismatch mapper.SchemaOptionMismatch) int {
+	var schemaNode *yaml.Node
+	switch mismatch.ObjectType {
+	case mapper.ObjectTypeResource:
+		schemaNode = mappingValue(mappingValue(mappingValue(configNode, "resources"), mismatch.ObjectName), "schema")
+	case mapper.ObjectTypeDataSource:
+		schemaNode = mappingValue(mappingValue(mappingValue(configNode, "data_sources"), mismatch.ObjectName), "schema")
+	case mapper.ObjectTypeProvider:
+		// Provider ignores are defined directly on the provider, not in a schema section
+		schemaNode = mappingValue(configNode, "provider")
+	case mapper.ObjectTypeDefaults:
+		schemaNode = mappingValue(configNode, "defaults")
+	}
+
+	var optionNode *yaml.Node
+	switch mismatch.Option {
+	case mapper.SchemaOptionIgnore:
+		if ignores := mappingValue(schemaNode, "ignores"); ignores != nil {
+			for _, ignore := range ignores.Content {
+				if ignore.Value == mismatch.Value {
+					optionNode = ignore
+					break
+				}
+			}
+		}
+	case mapper.SchemaOptionOverride:
+		optionNode = mappingKey(mappingValue(mappingValue(schemaNode, "attributes"), "overrides"), mismatch.Value)
+	case mapper.SchemaOptionAlias:
+		optionNode = mappingKey(mappingValue(mappingValue(schemaNode, "attributes"), "aliases"), mismatch.Value)
+	}
+
+	if optionNode == nil {
+		return 0
+	}
+
+	return optionNode.Line
+}
+
+func mappingKey(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i]
+		}
+	}
+
+	return nil
+}
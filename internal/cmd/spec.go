@@ -0,0 +1,154 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+
+	"github.com/pb33f/libopenapi"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/index"
+)
+
+// specFlags are the arguments and flags for reading OpenAPI specs, shared by all commands that build an OpenAPI model.
+type specFlags struct {
+	oasInputPaths       []string
+	flagBasePath        string
+	flagAllowedRefRoots stringSliceFlag
+	flagOverlayPaths    stringSliceFlag
+}
+
+func (f *specFlags) addFlags(fs *flag.FlagSet) {
+	fs.StringVar(&f.flagBasePath, "base-path", "", "base path for resolving relative file references (defaults to the directory containing all OpenAPI specs)")
+	fs.Var(&f.flagAllowedRefRoots, "allowed-ref-root", "additional directory that relative file references can resolve to (repeatable)")
+	fs.Var(&f.flagOverlayPaths, "overlay", "path to OpenAPI Overlay file applied to the OpenAPI spec before mapping (repeatable, applied in order)")
+}
+
+// buildModel reads all OpenAPI specs, applies overlays, converts Swagger 2.0 specs, merges multiple specs into one, and builds
+// the OpenAPI 3.x model.
+func (f *specFlags) buildModel(logger *slog.Logger, cfg config.Config) (high.Document, error) {
+	// 1. Read and parse OpenAPI spec files, merging them into one OpenAPI spec if multiple are provided
+	specPaths, err := findSpecFiles(f.oasInputPaths)
+	if err != nil {
+		return high.Document{}, err
+	}
+
+	oasDocuments := make([][]byte, len(specPaths))
+	for i, specPath := range specPaths {
+		oasDocuments[i], err = os.ReadFile(specPath)
+		if err != nil {
+			return high.Document{}, fmt.Errorf("error reading OpenAPI spec file: %w", err)
+		}
+	}
+
+	// Overlays are applied to each OpenAPI spec document, before any references are resolved
+	oasDocuments, err = applyOverlays(logger, oasDocuments, f.flagOverlayPaths)
+	if err != nil {
+		return high.Document{}, err
+	}
+
+	// Swagger 2.0 documents are converted to OpenAPI 3.0, so the same OpenAPI 3.x model can be used for mapping
+	for i, specPath := range specPaths {
+		var converted bool
+		oasDocuments[i], converted, err = convertSwagger2(logger, oasDocuments[i])
+		if err != nil {
+			return high.Document{}, fmt.Errorf("%s: %w", specPath, err)
+		}
+		if converted {
+			logger.Info("converted Swagger 2.0 spec to OpenAPI 3.0", "spec", specPath)
+		}
+	}
+
+	specDocuments, err := parseSpecDocuments(specPaths, oasDocuments)
+	if err != nil {
+		return high.Document{}, err
+	}
+
+	err = checkDocumentLocations(cfg, specDocuments)
+	if err != nil {
+		return high.Document{}, fmt.Errorf("error finding generator config operations in OpenAPI spec documents:\n%w", err)
+	}
+
+	oasInputPath, oasBytes, basePath := specPaths[0], oasDocuments[0], f.flagBasePath
+	if len(specDocuments) > 1 {
+		if basePath == "" {
+			basePath, err = commonDirectory(specPaths)
+			if err != nil {
+				return high.Document{}, err
+			}
+		}
+
+		basePath, err = filepath.Abs(basePath)
+		if err != nil {
+			return high.Document{}, fmt.Errorf("error determining absolute base path: %w", err)
+		}
+
+		oasInputPath = filepath.Join(basePath, mergedSpecFileName)
+		oasBytes, err = mergeSpecDocuments(specDocuments, basePath)
+		if err != nil {
+			return high.Document{}, err
+		}
+	}
+
+	docConfig, err := newDocumentConfiguration(oasInputPath, basePath)
+	if err != nil {
+		return high.Document{}, err
+	}
+
+	// Disallowed file references are checked first, so files outside of the allowed roots are never read
+	err = checkFileReferenceRoots(oasBytes, oasInputPath, docConfig.BasePath, f.flagAllowedRefRoots)
+	if err != nil {
+		return high.Document{}, fmt.Errorf("error resolving OpenAPI spec file references:\n%w", err)
+	}
+
+	doc, err := libopenapi.NewDocumentWithConfiguration(oasBytes, docConfig)
+	if err != nil {
+		return high.Document{}, fmt.Errorf("error parsing OpenAPI spec file: %w", err)
+	}
+
+	// 2. Build out the OpenAPI model, this will recursively load all local + relative file references into one cohesive model
+	model, errs := doc.BuildV3Model()
+
+	// 3. Fail on any unresolvable file references, log circular references as warnings, and fail on any other model building errors
+	err = checkFileReferences(doc.GetRolodex(), oasInputPath)
+	if err != nil {
+		return high.Document{}, fmt.Errorf("error resolving OpenAPI spec file references:\n%w", err)
+	}
+
+	var errResult error
+	for _, err := range errs {
+		if rslvErr, ok := err.(*index.ResolvingError); ok {
+			logger.Warn(
+				"circular reference found in OpenAPI spec",
+				"circular_ref", rslvErr.CircularReference.GenerateJourneyPath())
+			continue
+		}
+
+		errResult = errors.Join(errResult, err)
+	}
+	if errResult != nil {
+		return high.Document{}, fmt.Errorf("error building OpenAPI 3.x model: %w", errResult)
+	}
+
+	return model.Model, nil
+}
+
+// newExplorer returns the explorer for the generator config, which includes resources, data sources, and the provider discovered
+// from spec extensions when enabled.
+func newExplorer(spec high.Document, cfg config.Config) explorer.Explorer {
+	var oasExplorer explorer.Explorer = explorer.NewConfigExplorer(spec, cfg)
+	if cfg.SpecExtensions {
+		oasExplorer = explorer.NewMergedExplorer(explorer.NewExtensionExplorer(spec), oasExplorer)
+	}
+
+	return oasExplorer
+}
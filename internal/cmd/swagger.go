@@ -0,0 +1,768 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	swaggerConvertedVersion = "3.0.3"
+
+	mediaTypeJSON           = "application/json"
+	mediaTypeFormURLEncoded = "application/x-www-form-urlencoded"
+	mediaTypeMultipartForm  = "multipart/form-data"
+)
+
+// Operation keys that are valid in both a Swagger 2.0 and OpenAPI 3.0 path item.
+var swaggerOperationKeys = []string{"get", "put", "post", "delete", "options", "head", "patch"}
+
+// Keywords that are defined directly on a Swagger 2.0 parameter or header, which are moved to the schema in OpenAPI 3.0.
+var swaggerSchemaKeywords = []string{
+	"type", "format", "items", "enum", "default", "maximum", "exclusiveMaximum", "minimum", "exclusiveMinimum",
+	"maxLength", "minLength", "pattern", "maxItems", "minItems", "uniqueItems", "multipleOf",
+}
+
+// convertSwagger2 will convert a [Swagger 2.0] document into an [OpenAPI 3.0] document, so it can be built into the OpenAPI 3.x model
+// used by the explorer and mapper. If the document is not a Swagger 2.0 document, it's returned unmodified. The conversion includes:
+//   - definitions, parameters, and responses are moved to components, with all $ref values updated
+//   - body and formData parameters are converted to request bodies, using the consumes media types
+//   - response schemas are converted to response content, using the produces media types
+//   - Parameter and header keywords, like type and format, are moved to a schema
+//   - host, basePath, and schemes are converted to servers
+//
+// References to definitions, parameters, or responses in other files return an error, as those files aren't converted.
+//
+// [Swagger 2.0]: https://spec.openapis.org/oas/v2.0.html
+// [OpenAPI 3.0]: https://spec.openapis.org/oas/v3.0.3.html
+func convertSwagger2(logger *slog.Logger, oasBytes []byte) ([]byte, bool, error) {
+	var root yaml.Node
+	err := yaml.Unmarshal(oasBytes, &root)
+	if err != nil {
+		return nil, false, fmt.Errorf("error parsing OpenAPI spec file: %w", err)
+	}
+
+	if root.Kind != yaml.DocumentNode || len(root.Content) == 0 || root.Content[0].Kind != yaml.MappingNode {
+		return oasBytes, false, nil
+	}
+
+	doc := root.Content[0]
+	version := mappingValue(doc, "swagger")
+	if version == nil || !strings.HasPrefix(version.Value, "2.") {
+		return oasBytes, false, nil
+	}
+
+	err = checkSwaggerFileReferences(doc)
+	if err != nil {
+		return nil, false, fmt.Errorf("error converting Swagger 2.0 spec:\n%w", err)
+	}
+
+	converter := newSwaggerConverter(logger, doc)
+	converter.convert()
+
+	bytes, err := yaml.Marshal(&root)
+	if err != nil {
+		return nil, false, fmt.Errorf("error marshalling converted Swagger 2.0 spec: %w", err)
+	}
+
+	return bytes, true, nil
+}
+
+type swaggerConverter struct {
+	logger *slog.Logger
+	doc    *yaml.Node
+
+	// Global media types, used when an operation doesn't define consumes or produces
+	consumes []string
+	produces []string
+
+	// Global parameter definitions, used to inline body and formData parameters that are converted to request bodies
+	parameters *yaml.Node
+}
+
+func newSwaggerConverter(logger *slog.Logger, doc *yaml.Node) *swaggerConverter {
+	return &swaggerConverter{
+		logger:     logger,
+		doc:        doc,
+		consumes:   scalarValues(mappingValue(doc, "consumes"), []string{mediaTypeJSON}),
+		produces:   scalarValues(mappingValue(doc, "produces"), []string{mediaTypeJSON}),
+		parameters: mappingValue(doc, "parameters"),
+	}
+}
+
+func (c *swaggerConverter) convert() {
+	components := newMappingNode()
+
+	if definitions := mappingValue(c.doc, "definitions"); definitions != nil {
+		setMappingValue(components, "schemas", definitions)
+	}
+
+	if c.parameters != nil {
+		parameters := newMappingNode()
+		for i := 0; i+1 < len(c.parameters.Content); i += 2 {
+			// Body and formData parameters are inlined into request bodies when converting operations
+			if isRequestBodyParameter(c.parameters.Content[i+1]) {
+				continue
+			}
+			setMappingValue(parameters, c.parameters.Content[i].Value, c.convertParameter(c.parameters.Content[i+1]))
+		}
+		if len(parameters.Content) > 0 {
+			setMappingValue(components, "parameters", parameters)
+		}
+	}
+
+	if responses := mappingValue(c.doc, "responses"); responses != nil {
+		for i := 0; i+1 < len(responses.Content); i += 2 {
+			responses.Content[i+1] = c.convertResponse(responses.Content[i+1], c.produces)
+		}
+		setMappingValue(components, "responses", responses)
+	}
+
+	if securityDefinitions := mappingValue(c.doc, "securityDefinitions"); securityDefinitions != nil {
+		for i := 0; i+1 < len(securityDefinitions.Content); i += 2 {
+			securityDefinitions.Content[i+1] = convertSecurityScheme(securityDefinitions.Content[i+1])
+		}
+		setMappingValue(components, "securitySchemes", securityDefinitions)
+	}
+
+	if paths := mappingValue(c.doc, "paths"); paths != nil {
+		for i := 0; i+1 < len(paths.Content); i += 2 {
+			c.convertPathItem(paths.Content[i+1])
+		}
+	}
+
+	servers := convertServers(c.doc)
+
+	for _, key := range []string{"swagger", "host", "basePath", "schemes", "consumes", "produces", "definitions", "parameters", "responses", "securityDefinitions"} {
+		deleteMappingKey(c.doc, key)
+	}
+
+	// The openapi version is the first key, then the servers are placed before paths to match the usual document layout
+	c.doc.Content = append([]*yaml.Node{newScalarNode("openapi"), newScalarNode(swaggerConvertedVersion)}, c.doc.Content...)
+	if servers != nil {
+		insertMappingValueBefore(c.doc, "paths", "servers", servers)
+	}
+	if len(components.Content) > 0 {
+		setMappingValue(c.doc, "components", components)
+	}
+
+	convertDocumentSchemas(c.doc)
+}
+
+func (c *swaggerConverter) convertPathItem(pathItem *yaml.Node) {
+	if pathItem.Kind != yaml.MappingNode {
+		return
+	}
+
+	// Path-level body and formData parameters apply to every operation, all others are kept at the path-level
+	pathParameters, pathBodyParameters := c.splitParameters(mappingValue(pathItem, "parameters"))
+	if pathParameters != nil {
+		setMappingValue(pathItem, "parameters", pathParameters)
+	}
+
+	for _, key := range swaggerOperationKeys {
+		if operation := mappingValue(pathItem, key); operation != nil {
+			c.convertOperation(operation, pathBodyParameters)
+		}
+	}
+}
+
+func (c *swaggerConverter) convertOperation(operation *yaml.Node, pathBodyParameters []*yaml.Node) {
+	consumes := scalarValues(mappingValue(operation, "consumes"), c.consumes)
+	produces := scalarValues(mappingValue(operation, "produces"), c.produces)
+
+	parameters, bodyParameters := c.splitParameters(mappingValue(operation, "parameters"))
+
+	// Operation parameters override path-level parameters with the same name
+	for _, pathBodyParameter := range pathBodyParameters {
+		name := scalarValue(mappingValue(pathBodyParameter, "name"))
+		if !slices.ContainsFunc(bodyParameters, func(p *yaml.Node) bool { return scalarValue(mappingValue(p, "name")) == name }) {
+			bodyParameters = append(bodyParameters, pathBodyParameter)
+		}
+	}
+
+	if parameters != nil {
+		setMappingValue(operation, "parameters", parameters)
+	} else {
+		deleteMappingKey(operation, "parameters")
+	}
+
+	if requestBody := convertRequestBody(bodyParameters, consumes); requestBody != nil {
+		insertMappingValueBefore(operation, "responses", "requestBody", requestBody)
+	}
+
+	if responses := mappingValue(operation, "responses"); responses != nil {
+		for i := 0; i+1 < len(responses.Content); i += 2 {
+			responses.Content[i+1] = c.convertResponse(responses.Content[i+1], produces)
+		}
+	}
+
+	for _, key := range []string{"consumes", "produces", "schemes"} {
+		deleteMappingKey(operation, key)
+	}
+}
+
+// splitParameters converts all parameters that remain parameters in OpenAPI 3.0 and returns them, separately returning all body and
+// formData parameters, which will be converted to a request body. References to global body and formData parameters are inlined.
+func (c *swaggerConverter) splitParameters(parameters *yaml.Node) (*yaml.Node, []*yaml.Node) {
+	if parameters == nil || parameters.Kind != yaml.SequenceNode {
+		return nil, nil
+	}
+
+	converted := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	bodyParameters := []*yaml.Node{}
+
+	for _, parameter := range parameters.Content {
+		if ref := mappingValue(parameter, "$ref"); ref != nil {
+			resolved := c.resolveParameter(ref.Value)
+			if resolved != nil && isRequestBodyParameter(resolved) {
+				bodyParameters = append(bodyParameters, resolved)
+				continue
+			}
+
+			// References to other parameters are updated to components with the rest of the $refs
+			converted.Content = append(converted.Content, parameter)
+			continue
+		}
+
+		if isRequestBodyParameter(parameter) {
+			bodyParameters = append(bodyParameters, parameter)
+			continue
+		}
+
+		converted.Content = append(converted.Content, c.convertParameter(parameter))
+	}
+
+	if len(converted.Content) == 0 {
+		return nil, bodyParameters
+	}
+
+	return converted, bodyParameters
+}
+
+func (c *swaggerConverter) resolveParameter(ref string) *yaml.Node {
+	name, ok := strings.CutPrefix(ref, "#/parameters/")
+	if !ok || c.parameters == nil {
+		return nil
+	}
+
+	return mappingValue(c.parameters, name)
+}
+
+func (c *swaggerConverter) convertResponse(response *yaml.Node, produces []string) *yaml.Node {
+	if response.Kind != yaml.MappingNode || mappingValue(response, "$ref") != nil {
+		return response
+	}
+
+	converted := newMappingNode()
+	description := mappingValue(response, "description")
+	if description == nil {
+		description = newScalarNode("")
+	}
+	setMappingValue(converted, "description", description)
+
+	if headers := mappingValue(response, "headers"); headers != nil {
+		convertedHeaders := newMappingNode()
+		for i := 0; i+1 < len(headers.Content); i += 2 {
+			header := headers.Content[i+1]
+			convertedHeader := newMappingNode()
+			if headerDescription := mappingValue(header, "description"); headerDescription != nil {
+				setMappingValue(convertedHeader, "description", headerDescription)
+			}
+			setMappingValue(convertedHeader, "schema", extractSchema(header))
+			setMappingValue(convertedHeaders, headers.Content[i].Value, convertedHeader)
+		}
+		setMappingValue(converted, "headers", convertedHeaders)
+	}
+
+	if schema := mappingValue(response, "schema"); schema != nil {
+		setMappingValue(converted, "content", newContent(produces, schema))
+	}
+
+	copyExtensions(converted, response)
+
+	return converted
+}
+
+// convertParameter moves all schema keywords of a Swagger 2.0 query, header, path, or formData parameter into a schema, and converts
+// the collectionFormat of array parameters to the equivalent style and explode.
+func (c *swaggerConverter) convertParameter(parameter *yaml.Node) *yaml.Node {
+	if parameter.Kind != yaml.MappingNode || mappingValue(parameter, "$ref") != nil {
+		return parameter
+	}
+
+	converted := newMappingNode()
+	for _, key := range []string{"name", "in", "description", "required", "allowEmptyValue"} {
+		if value := mappingValue(parameter, key); value != nil {
+			setMappingValue(converted, key, value)
+		}
+	}
+
+	switch collectionFormat := scalarValue(mappingValue(parameter, "collectionFormat")); collectionFormat {
+	case "csv":
+		setMappingValue(converted, "explode", newBoolNode(false))
+	case "ssv":
+		setMappingValue(converted, "style", newScalarNode("spaceDelimited"))
+		setMappingValue(converted, "explode", newBoolNode(false))
+	case "pipes":
+		setMappingValue(converted, "style", newScalarNode("pipeDelimited"))
+		setMappingValue(converted, "explode", newBoolNode(false))
+	case "multi":
+		setMappingValue(converted, "explode", newBoolNode(true))
+	case "tsv":
+		c.logger.Warn(
+			"collectionFormat of Swagger 2.0 parameter has no OpenAPI 3.0 equivalent, using the default style",
+			"parameter", scalarValue(mappingValue(parameter, "name")),
+			"collection_format", collectionFormat)
+	}
+
+	setMappingValue(converted, "schema", extractSchema(parameter))
+	copyExtensions(converted, parameter)
+
+	return converted
+}
+
+// convertRequestBody converts all body and formData parameters of an operation into a request body.
+func convertRequestBody(bodyParameters []*yaml.Node, consumes []string) *yaml.Node {
+	if len(bodyParameters) == 0 {
+		return nil
+	}
+
+	requestBody := newMappingNode()
+
+	// A body parameter is the entire request body, so any formData parameters are ignored (Swagger 2.0 doesn't allow both)
+	for _, parameter := range bodyParameters {
+		if scalarValue(mappingValue(parameter, "in")) != "body" {
+			continue
+		}
+
+		if description := mappingValue(parameter, "description"); description != nil {
+			setMappingValue(requestBody, "description", description)
+		}
+		if required := mappingValue(parameter, "required"); required != nil {
+			setMappingValue(requestBody, "required", required)
+		}
+
+		schema := mappingValue(parameter, "schema")
+		if schema == nil {
+			schema = newMappingNode()
+		}
+		setMappingValue(requestBody, "content", newContent(consumes, schema))
+
+		return requestBody
+	}
+
+	schema := newMappingNode()
+	setMappingValue(schema, "type", newScalarNode("object"))
+	properties := newMappingNode()
+	required := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	isMultipart := slices.Contains(consumes, mediaTypeMultipartForm)
+
+	for _, parameter := range bodyParameters {
+		name := scalarValue(mappingValue(parameter, "name"))
+		property := extractSchema(parameter)
+		if description := mappingValue(parameter, "description"); description != nil {
+			setMappingValue(property, "description", description)
+		}
+		setMappingValue(properties, name, property)
+
+		if scalarValue(mappingValue(parameter, "required")) == "true" {
+			required.Content = append(required.Content, newScalarNode(name))
+		}
+		if scalarValue(mappingValue(parameter, "type")) == "file" {
+			isMultipart = true
+		}
+	}
+
+	setMappingValue(schema, "properties", properties)
+	if len(required.Content) > 0 {
+		setMappingValue(schema, "required", required)
+		setMappingValue(requestBody, "required", newBoolNode(true))
+	}
+
+	mediaType := mediaTypeFormURLEncoded
+	if isMultipart {
+		mediaType = mediaTypeMultipartForm
+	}
+	setMappingValue(requestBody, "content", newContent([]string{mediaType}, schema))
+
+	return requestBody
+}
+
+func convertSecurityScheme(securityScheme *yaml.Node) *yaml.Node {
+	schemeType := scalarValue(mappingValue(securityScheme, "type"))
+	if schemeType == "apiKey" {
+		return securityScheme
+	}
+
+	converted := newMappingNode()
+	if description := mappingValue(securityScheme, "description"); description != nil {
+		setMappingValue(converted, "description", description)
+	}
+
+	switch schemeType {
+	case "basic":
+		setMappingValue(converted, "type", newScalarNode("http"))
+		setMappingValue(converted, "scheme", newScalarNode("basic"))
+	case "oauth2":
+		setMappingValue(converted, "type", newScalarNode("oauth2"))
+
+		flowNames := map[string]string{
+			"implicit":    "implicit",
+			"password":    "password",
+			"application": "clientCredentials",
+			"accessCode":  "authorizationCode",
+		}
+
+		flow := newMappingNode()
+		for _, key := range []string{"authorizationUrl", "tokenUrl", "scopes"} {
+			if value := mappingValue(securityScheme, key); value != nil {
+				setMappingValue(flow, key, value)
+			}
+		}
+
+		flows := newMappingNode()
+		setMappingValue(flows, flowNames[scalarValue(mappingValue(securityScheme, "flow"))], flow)
+		setMappingValue(converted, "flows", flows)
+	}
+
+	copyExtensions(converted, securityScheme)
+
+	return converted
+}
+
+// convertServers creates a servers list from the host, basePath, and schemes of the Swagger 2.0 document.
+func convertServers(doc *yaml.Node) *yaml.Node {
+	host := scalarValue(mappingValue(doc, "host"))
+	basePath := scalarValue(mappingValue(doc, "basePath"))
+	if host == "" && basePath == "" {
+		return nil
+	}
+
+	schemes := []string{""}
+	if host != "" {
+		schemes = scalarValues(mappingValue(doc, "schemes"), []string{"https"})
+	}
+
+	servers := &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+	for _, scheme := range schemes {
+		url := basePath
+		if host != "" {
+			url = fmt.Sprintf("%s://%s%s", scheme, host, basePath)
+		}
+
+		server := newMappingNode()
+		setMappingValue(server, "url", newScalarNode(url))
+		servers.Content = append(servers.Content, server)
+	}
+
+	return servers
+}
+
+// convertDocumentSchemas updates all Swagger 2.0 specific keywords in the schemas of the converted document to OpenAPI 3.0, along with
+// the $ref values of schemas, parameters, responses, and path items. Only schema locations are converted, so values like examples,
+// defaults, enums, and extensions are kept as-is.
+func convertDocumentSchemas(doc *yaml.Node) {
+	if components := mappingValue(doc, "components"); components != nil {
+		forEachMappingValue(mappingValue(components, "schemas"), convertSchema)
+		forEachMappingValue(mappingValue(components, "parameters"), convertParameterSchema)
+		forEachMappingValue(mappingValue(components, "responses"), convertResponseSchemas)
+	}
+
+	forEachMappingValue(mappingValue(doc, "paths"), func(pathItem *yaml.Node) {
+		convertReferenceValue(pathItem)
+
+		for _, parameter := range sequenceValues(mappingValue(pathItem, "parameters")) {
+			convertParameterSchema(parameter)
+		}
+
+		for _, key := range swaggerOperationKeys {
+			operation := mappingValue(pathItem, key)
+			if operation == nil {
+				continue
+			}
+
+			for _, parameter := range sequenceValues(mappingValue(operation, "parameters")) {
+				convertParameterSchema(parameter)
+			}
+			convertContentSchemas(mappingValue(mappingValue(operation, "requestBody"), "content"))
+			forEachMappingValue(mappingValue(operation, "responses"), convertResponseSchemas)
+		}
+	})
+}
+
+func convertParameterSchema(parameter *yaml.Node) {
+	convertReferenceValue(parameter)
+
+	if schema := mappingValue(parameter, "schema"); schema != nil {
+		convertSchema(schema)
+	}
+}
+
+func convertResponseSchemas(response *yaml.Node) {
+	convertReferenceValue(response)
+	convertContentSchemas(mappingValue(response, "content"))
+
+	forEachMappingValue(mappingValue(response, "headers"), func(header *yaml.Node) {
+		if schema := mappingValue(header, "schema"); schema != nil {
+			convertSchema(schema)
+		}
+	})
+}
+
+func convertContentSchemas(content *yaml.Node) {
+	forEachMappingValue(content, func(mediaType *yaml.Node) {
+		if schema := mappingValue(mediaType, "schema"); schema != nil {
+			convertSchema(schema)
+		}
+	})
+}
+
+// convertSchema updates the Swagger 2.0 specific keywords and references of a schema and all of its subschemas.
+func convertSchema(schema *yaml.Node) {
+	if schema.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i+1 < len(schema.Content); i += 2 {
+		key, value := schema.Content[i], schema.Content[i+1]
+
+		switch key.Value {
+		case "$ref":
+			convertReferenceValue(schema)
+		case "x-nullable":
+			key.Value = "nullable"
+		case "discriminator":
+			if value.Kind == yaml.ScalarNode {
+				discriminator := newMappingNode()
+				setMappingValue(discriminator, "propertyName", newScalarNode(value.Value))
+				schema.Content[i+1] = discriminator
+			}
+		case "type":
+			if value.Kind == yaml.ScalarNode && value.Value == "file" {
+				value.Value = "string"
+				if mappingValue(schema, "format") == nil {
+					setMappingValue(schema, "format", newScalarNode("binary"))
+				}
+			}
+		case "properties":
+			forEachMappingValue(value, convertSchema)
+		case "items", "additionalProperties", "not":
+			convertSchema(value)
+			for _, item := range sequenceValues(value) {
+				convertSchema(item)
+			}
+		case "allOf", "anyOf", "oneOf":
+			for _, subschema := range sequenceValues(value) {
+				convertSchema(subschema)
+			}
+		}
+	}
+}
+
+// convertReferenceValue updates the $ref value of the node, if it has one.
+func convertReferenceValue(node *yaml.Node) {
+	if ref := mappingValue(node, "$ref"); ref != nil && ref.Kind == yaml.ScalarNode {
+		ref.Value = convertReference(ref.Value)
+	}
+}
+
+// convertReference updates a local reference to a Swagger 2.0 definition, parameter, or response to the OpenAPI 3.0 components. References
+// to other files are returned unmodified, as other files aren't converted, see checkSwaggerFileReferences.
+func convertReference(ref string) string {
+	file, pointer, found := strings.Cut(ref, "#")
+	if !found || file != "" {
+		return ref
+	}
+
+	if componentsPointer, ok := swaggerComponentsPointer(pointer); ok {
+		return "#" + componentsPointer
+	}
+
+	return ref
+}
+
+// swaggerComponentsPointer returns the OpenAPI 3.0 components pointer of a JSON pointer to a Swagger 2.0 definition, parameter, or
+// response, or false if the JSON pointer doesn't point to one of them.
+func swaggerComponentsPointer(pointer string) (string, bool) {
+	for swaggerPrefix, componentsPrefix := range map[string]string{
+		"/definitions/": "/components/schemas/",
+		"/parameters/":  "/components/parameters/",
+		"/responses/":   "/components/responses/",
+	} {
+		if name, ok := strings.CutPrefix(pointer, swaggerPrefix); ok {
+			return componentsPrefix + name, true
+		}
+	}
+
+	return "", false
+}
+
+// checkSwaggerFileReferences returns an error for every reference to a definition, parameter, or response in another file. Only the
+// Swagger 2.0 spec itself is converted to OpenAPI 3.0, so those references would point to locations that don't exist in the
+// converted spec. References to entire files, like a schema file, are resolved as-is.
+func checkSwaggerFileReferences(root *yaml.Node) error {
+	var errResult error
+	for _, refNode := range findReferenceNodes(root) {
+		file, pointer, _ := strings.Cut(refNode.Value, "#")
+		if file == "" {
+			continue
+		}
+
+		if _, ok := swaggerComponentsPointer(pointer); ok {
+			errResult = errors.Join(errResult, fmt.Errorf("line %d: reference '%s' to another Swagger 2.0 file isn't supported, as only the referencing spec is converted to OpenAPI 3.0", refNode.Line, refNode.Value))
+		}
+	}
+
+	return errResult
+}
+
+// extractSchema returns a schema containing all schema keywords defined directly on a Swagger 2.0 parameter or header.
+func extractSchema(node *yaml.Node) *yaml.Node {
+	schema := newMappingNode()
+	for _, key := range swaggerSchemaKeywords {
+		if value := mappingValue(node, key); value != nil {
+			setMappingValue(schema, key, value)
+		}
+	}
+
+	if items := mappingValue(schema, "items"); items != nil {
+		deleteMappingKey(items, "collectionFormat")
+	}
+
+	return schema
+}
+
+func isRequestBodyParameter(parameter *yaml.Node) bool {
+	in := scalarValue(mappingValue(parameter, "in"))
+	return in == "body" || in == "formData"
+}
+
+func newContent(mediaTypes []string, schema *yaml.Node) *yaml.Node {
+	content := newMappingNode()
+	for _, mediaType := range mediaTypes {
+		mediaTypeObject := newMappingNode()
+		setMappingValue(mediaTypeObject, "schema", schema)
+		setMappingValue(content, mediaType, mediaTypeObject)
+	}
+
+	return content
+}
+
+func copyExtensions(target *yaml.Node, source *yaml.Node) {
+	for i := 0; i+1 < len(source.Content); i += 2 {
+		if strings.HasPrefix(source.Content[i].Value, "x-") {
+			setMappingValue(target, source.Content[i].Value, source.Content[i+1])
+		}
+	}
+}
+
+func newMappingNode() *yaml.Node {
+	return &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+}
+
+func newScalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+func newBoolNode(value bool) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: fmt.Sprintf("%t", value)}
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return nil
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
+
+func setMappingValue(node *yaml.Node, key string, value *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content[i+1] = value
+			return
+		}
+	}
+
+	node.Content = append(node.Content, newScalarNode(key), value)
+}
+
+// insertMappingValueBefore sets the key in the mapping, positioned before an existing key. If the existing key is not found, it's
+// positioned at the end of the mapping.
+func insertMappingValueBefore(node *yaml.Node, beforeKey string, key string, value *yaml.Node) {
+	deleteMappingKey(node, key)
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == beforeKey {
+			node.Content = slices.Insert(node.Content, i, newScalarNode(key), value)
+			return
+		}
+	}
+
+	node.Content = append(node.Content, newScalarNode(key), value)
+}
+
+func deleteMappingKey(node *yaml.Node, key string) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = slices.Delete(node.Content, i, i+2)
+			return
+		}
+	}
+}
+
+// forEachMappingValue calls the function with every value of a mapping node.
+func forEachMappingValue(node *yaml.Node, fn func(value *yaml.Node)) {
+	if node == nil || node.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		fn(node.Content[i+1])
+	}
+}
+
+// sequenceValues returns all values of a sequence node, or nil if the node isn't a sequence.
+func sequenceValues(node *yaml.Node) []*yaml.Node {
+	if node == nil || node.Kind != yaml.SequenceNode {
+		return nil
+	}
+
+	return node.Content
+}
+
+func scalarValue(node *yaml.Node) string {
+	if node == nil || node.Kind != yaml.ScalarNode {
+		return ""
+	}
+
+	return node.Value
+}
+
+// scalarValues returns all scalar values in a sequence node, or the default values if the node is empty.
+func scalarValues(node *yaml.Node, defaultValues []string) []string {
+	if node == nil || node.Kind != yaml.SequenceNode || len(node.Content) == 0 {
+		return defaultValues
+	}
+
+	values := []string{}
+	for _, child := range node.Content {
+		values = append(values, child.Value)
+	}
+
+	return values
+}
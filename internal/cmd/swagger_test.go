@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+)
+
+func TestConvertSwagger2(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpec           string
+		expectedOASSpec   string
+		expectedConverted bool
+		expectedWarnings  []string
+	}{
+		"openapi 3 - unmodified": {
+			oasSpec: `openapi: 3.1.0
+paths: {}
+`,
+			expectedOASSpec: `openapi: 3.1.0
+paths: {}
+`,
+			expectedConverted: false,
+		},
+		"definitions and body parameters": {
+			oasSpec: `swagger: "2.0"
+info:
+  title: Test
+  version: 1.0.0
+host: api.example.com
+basePath: /v1
+consumes:
+  - application/json
+paths:
+  /things:
+    post:
+      produces:
+        - application/xml
+      parameters:
+        - name: body
+          in: body
+          description: The thing to create
+          required: true
+          schema:
+            $ref: "#/definitions/thing"
+      responses:
+        "201":
+          description: Created
+          schema:
+            $ref: "#/definitions/thing"
+definitions:
+  thing:
+    type: object
+    discriminator: kind
+    properties:
+      kind:
+        type: string
+      name:
+        type: string
+        x-nullable: true
+`,
+			expectedOASSpec: `openapi: 3.0.3
+info:
+    title: Test
+    version: 1.0.0
+servers:
+    - url: https://api.example.com/v1
+paths:
+    /things:
+        post:
+            requestBody:
+                description: The thing to create
+                required: true
+                content:
+                    application/json:
+                        schema:
+                            $ref: "#/components/schemas/thing"
+            responses:
+                "201":
+                    description: Created
+                    content:
+                        application/xml:
+                            schema:
+                                $ref: "#/components/schemas/thing"
+components:
+    schemas:
+        thing:
+            type: object
+            discriminator:
+                propertyName: kind
+            properties:
+                kind:
+                    type: string
+                name:
+                    type: string
+                    nullable: true
+`,
+			expectedConverted: true,
+		},
+		"parameters and formData": {
+			oasSpec: `swagger: "2.0"
+parameters:
+  thing_id:
+    name: thing_id
+    in: path
+    required: true
+    type: string
+  name:
+    name: name
+    in: formData
+    required: true
+    type: string
+paths:
+  /things/{thing_id}:
+    parameters:
+      - $ref: "#/parameters/thing_id"
+    put:
+      parameters:
+        - $ref: "#/parameters/name"
+        - name: tags
+          in: query
+          type: array
+          collectionFormat: multi
+          items:
+            type: string
+        - name: file
+          in: formData
+          type: file
+      responses:
+        "200":
+          description: OK
+          headers:
+            X-Rate-Limit:
+              type: integer
+`,
+			expectedOASSpec: `openapi: 3.0.3
+paths:
+    /things/{thing_id}:
+        parameters:
+            - $ref: "#/components/parameters/thing_id"
+        put:
+            parameters:
+                - name: tags
+                  in: query
+                  explode: true
+                  schema:
+                    type: array
+                    items:
+                        type: string
+            requestBody:
+                required: true
+                content:
+                    multipart/form-data:
+                        schema:
+                            type: object
+                            properties:
+                                name:
+                                    type: string
+                                file:
+                                    type: string
+                                    format: binary
+                            required:
+                                - name
+            responses:
+                "200":
+                    description: OK
+                    headers:
+                        X-Rate-Limit:
+                            schema:
+                                type: integer
+components:
+    parameters:
+        thing_id:
+            name: thing_id
+            in: path
+            required: true
+            schema:
+                type: string
+`,
+			expectedConverted: true,
+		},
+		"collection formats": {
+			oasSpec: `swagger: "2.0"
+paths:
+  /things:
+    get:
+      parameters:
+        - name: csv
+          in: query
+          type: array
+          collectionFormat: csv
+          items:
+            type: string
+        - name: ssv
+          in: query
+          type: array
+          collectionFormat: ssv
+          items:
+            type: string
+        - name: pipes
+          in: query
+          type: array
+          collectionFormat: pipes
+          items:
+            type: string
+        - name: tsv
+          in: query
+          type: array
+          collectionFormat: tsv
+          items:
+            type: string
+      responses:
+        "200":
+          description: OK
+`,
+			expectedOASSpec: `openapi: 3.0.3
+paths:
+    /things:
+        get:
+            parameters:
+                - name: csv
+                  in: query
+                  explode: false
+                  schema:
+                    type: array
+                    items:
+                        type: string
+                - name: ssv
+                  in: query
+                  style: spaceDelimited
+                  explode: false
+                  schema:
+                    type: array
+                    items:
+                        type: string
+                - name: pipes
+                  in: query
+                  style: pipeDelimited
+                  explode: false
+                  schema:
+                    type: array
+                    items:
+                        type: string
+                - name: tsv
+                  in: query
+                  schema:
+                    type: array
+                    items:
+                        type: string
+            responses:
+                "200":
+                    description: OK
+`,
+			expectedConverted: true,
+			expectedWarnings: []string{
+				"collectionFormat of Swagger 2.0 parameter has no OpenAPI 3.0 equivalent, using the default style",
+				"parameter=tsv",
+			},
+		},
+		"schema keywords only in schemas": {
+			oasSpec: `swagger: "2.0"
+paths: {}
+definitions:
+  upload:
+    type: object
+    example:
+      type: file
+      discriminator: kind
+      x-nullable: true
+    x-example-schema:
+      type: file
+    properties:
+      content:
+        type: file
+      metadata:
+        type: object
+        additionalProperties:
+          type: string
+          x-nullable: true
+        default:
+          x-nullable: true
+      kinds:
+        type: array
+        items:
+          allOf:
+            - $ref: "#/definitions/kind"
+        enum:
+          - - type: file
+  kind:
+    type: string
+`,
+			expectedOASSpec: `openapi: 3.0.3
+paths: {}
+components:
+    schemas:
+        upload:
+            type: object
+            example:
+                type: file
+                discriminator: kind
+                x-nullable: true
+            x-example-schema:
+                type: file
+            properties:
+                content:
+                    type: string
+                    format: binary
+                metadata:
+                    type: object
+                    additionalProperties:
+                        type: string
+                        nullable: true
+                    default:
+                        x-nullable: true
+                kinds:
+                    type: array
+                    items:
+                        allOf:
+                            - $ref: "#/components/schemas/kind"
+                    enum:
+                        - - type: file
+        kind:
+            type: string
+`,
+			expectedConverted: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			logs := &bytes.Buffer{}
+			logger := slog.New(slog.NewTextHandler(logs, &slog.HandlerOptions{
+				Level: slog.LevelWarn,
+			}))
+
+			got, converted, err := convertSwagger2(logger, []byte(testCase.oasSpec))
+			if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			if converted != testCase.expectedConverted {
+				t.Errorf("expected converted to be %t, got: %t", testCase.expectedConverted, converted)
+			}
+
+			if diff := cmp.Diff(string(got), testCase.expectedOASSpec); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+
+			for _, expectedWarning := range testCase.expectedWarnings {
+				if !strings.Contains(logs.String(), expectedWarning) {
+					t.Errorf("expected warning containing %q, got logs: %s", expectedWarning, logs.String())
+				}
+			}
+		})
+	}
+}
+
+func TestConvertSwagger2_FileReferences(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath      string
+		expectedErrRegex string
+	}{
+		"reference to schema file": {
+			oasSpecPath: "testdata/swagger2_multifile/openapi_spec.yml",
+		},
+		"reference to definition in another file": {
+			oasSpecPath:      "testdata/swagger2_multifile/definitions_ref_spec.yml",
+			expectedErrRegex: `definitions_ref_spec.yml: error converting Swagger 2.0 spec:\nline 12: reference './definitions.yml#/definitions/pet' to another Swagger 2.0 file isn't supported, as only the referencing spec is converted to OpenAPI 3.0\nline 17: `,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			flags := &specFlags{oasInputPaths: []string{testCase.oasSpecPath}}
+			model, err := flags.buildModel(slog.New(slog.NewTextHandler(io.Discard, nil)), config.Config{})
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedErrRegex)
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %s", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			pet, ok := model.Components.Schemas.Get("pet")
+			if !ok {
+				t.Fatal("expected converted 'pet' schema in components")
+			}
+
+			tag, ok := pet.Schema().Properties.Get("tag")
+			if !ok || tag.Schema().Properties.Len() != 1 {
+				t.Errorf("expected 'tag' property resolved from the referenced schema file")
+			}
+		})
+	}
+}
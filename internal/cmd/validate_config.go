@@ -0,0 +1,111 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+
+	"github.com/hashicorp/cli"
+)
+
+// errConfigMismatches is returned when the generator config has schema options that don't match the OpenAPI spec. Each mismatch
+// has already been output, so the error is not logged.
+var errConfigMismatches = errors.New("generator config doesn't match OpenAPI spec")
+
+type ValidateConfigCommand struct {
+	specFlags
+
+	UI             cli.Ui
+	flagConfigPath string
+}
+
+func (cmd *ValidateConfigCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("validate-config", flag.ExitOnError)
+	fs.StringVar(&cmd.flagConfigPath, "config", "./generator_config.yml", "path to generator config file (YAML)")
+	cmd.addFlags(fs)
+	return fs
+}
+
+func (cmd *ValidateConfigCommand) Help() string {
+	return flagsHelp("validate-config [<args>] </path/to/oas_file.yml> [</path/to/oas_file_or_directory> ...]", cmd.Flags())
+}
+
+func (cmd *ValidateConfigCommand) Synopsis() string {
+	return "Validates a generator config against an OpenAPI 3.x Specification"
+}
+
+func (cmd *ValidateConfigCommand) Run(args []string) int {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelWarn,
+	}))
+
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		logger.Error("error parsing flags", "err", err)
+		return 1
+	}
+
+	cmd.oasInputPaths = fs.Args()
+	if len(cmd.oasInputPaths) == 0 {
+		logger.Error("error executing command", "err", "at least one OpenAPI specification file or directory is required as last argument(s)")
+		return 1
+	}
+
+	err = cmd.runInternal(logger)
+	if errors.Is(err, errConfigMismatches) {
+		return 1
+	}
+	if err != nil {
+		logger.Error("error executing command", "err", err)
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *ValidateConfigCommand) runInternal(logger *slog.Logger) error {
+	// 1. Read and parse generator config file
+	configBytes, err := os.ReadFile(cmd.flagConfigPath)
+	if err != nil {
+		return fmt.Errorf("error reading generator config file: %w", err)
+	}
+	config, err := config.ParseConfig(configBytes)
+	if err != nil {
+		return fmt.Errorf("error parsing generator config file: %w", err)
+	}
+
+	// 2. Read and parse OpenAPI spec files, then build out the OpenAPI model
+	model, err := cmd.buildModel(logger, *config)
+	if err != nil {
+		return err
+	}
+
+	// 3. Find all operations in the generator config, then output any ignores, overrides, and aliases that don't match the OpenAPI spec
+	mismatches, err := findConfigMismatches(newExplorer(model, *config), *config, configBytes)
+	if err != nil {
+		return err
+	}
+
+	if len(mismatches) == 0 {
+		cmd.UI.Output(fmt.Sprintf("Generator config '%s' is valid.", cmd.flagConfigPath))
+		return nil
+	}
+
+	for _, mismatch := range mismatches {
+		if mismatch.Line == 0 {
+			cmd.UI.Error(fmt.Sprintf("%s: %s", cmd.flagConfigPath, mismatch.Error()))
+			continue
+		}
+		cmd.UI.Error(fmt.Sprintf("%s:%d: %s", cmd.flagConfigPath, mismatch.Line, mismatch.Error()))
+	}
+
+	return errConfigMismatches
+}
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/cli"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/cmd"
+)
+
+func TestValidateConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		oasSpecPath      string
+		configPath       string
+		expectedExitCode int
+		expectedOutput   string
+		expectedErrors   string
+	}{
+		"valid config": {
+			oasSpecPath:      "testdata/petstore3/openapi_spec.json",
+			configPath:       "testdata/petstore3/generator_config.yml",
+			expectedExitCode: 0,
+			expectedOutput:   "Generator config 'testdata/petstore3/generator_config.yml' is valid.\n",
+		},
+		"unmatched ignores, overrides, aliases, and defaults": {
+			oasSpecPath:      "testdata/petstore3/openapi_spec.json",
+			configPath:       "testdata/validateconfig/generator_config.yml",
+			expectedExitCode: 1,
+			expectedErrors: "testdata/validateconfig/generator_config.yml:15: resource 'pet' override 'nme' doesn't match any attribute, did you mean: 'name'?\n" +
+				"testdata/validateconfig/generator_config.yml:20: resource 'pet' alias 'pet_id' doesn't match any parameter or property, did you mean: 'petId', 'id'?\n" +
+				"testdata/validateconfig/generator_config.yml:29: data_source 'order' ignore 'shipdate' doesn't match any attribute, did you mean: 'shipDate'?\n" +
+				"testdata/validateconfig/generator_config.yml:34: defaults ignore '**.etag' doesn't match any attribute\n",
+		},
+	}
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mockUi := cli.NewMockUi()
+			c := cmd.ValidateConfigCommand{UI: mockUi}
+			args := []string{
+				"--config", testCase.configPath,
+				testCase.oasSpecPath,
+			}
+
+			exitCode := c.Run(args)
+			if exitCode != testCase.expectedExitCode {
+				t.Fatalf("expected exit code %d, got %d: %s", testCase.expectedExitCode, exitCode, mockUi.ErrorWriter.String())
+			}
+
+			if diff := cmp.Diff(mockUi.OutputWriter.String(), testCase.expectedOutput); diff != "" {
+				t.Errorf("unexpected difference in output: %s", diff)
+			}
+
+			if diff := cmp.Diff(mockUi.ErrorWriter.String(), testCase.expectedErrors); diff != "" {
+				t.Errorf("unexpected difference in errors: %s", diff)
+			}
+		})
+	}
+}
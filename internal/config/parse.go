@@ -7,23 +7,78 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"slices"
 
 	"gopkg.in/yaml.v3"
 )
 
-// This regex matches attribute locations, dot-separated, as represented as {attribute_name}.{nested_attribute_name}
+// This regex matches attribute locations, dot-separated, as represented as {attribute_name}.{nested_attribute_name}. Attribute
+// names can contain single `*` wildcards to match any characters, and a `**` part matches any number of nested attributes, but
+// can't be the last part.
 //   - category = MATCH
 //   - category.id = MATCH
 //   - category.tags.name = MATCH
+//   - *.etag = MATCH
+//   - **._links = MATCH
+//   - tags.* = MATCH
+//   - created_* = MATCH
 //   - category. = NO MATCH
 //   - .category = NO MATCH
-var attributeLocationRegex = regexp.MustCompile(`^[\w]+(?:\.[\w]+)*$`)
+//   - category.** = NO MATCH
+//   - ***.etag = NO MATCH
+//   - created**at = NO MATCH
+var attributeLocationRegex = regexp.MustCompile(`^(?:(?:\*\*|\*|\*?\w+(?:\*\w+)*\*?)\.)*(?:\*|\*?\w+(?:\*\w+)*\*?)$`)
 
 // Config represents a YAML generator config.
 type Config struct {
 	Provider    Provider              `yaml:"provider"`
 	Resources   map[string]Resource   `yaml:"resources"`
 	DataSources map[string]DataSource `yaml:"data_sources"`
+
+	// SpecExtensions enables discovery of resources, data sources, and the provider schema from `x-terraform-*` extensions in the
+	// OpenAPI spec. Resources and data sources defined in this config will be added to, or override, the discovered ones.
+	SpecExtensions bool `yaml:"spec_extensions"`
+
+	// Formats are a map, with the key being the `format` of a string schema in the OpenAPI spec and the value being options
+	// to apply to all attributes with that format.
+	Formats map[string]Format `yaml:"formats"`
+
+	// UniqueItemsAsSets maps all arrays with `uniqueItems: true` in the OpenAPI spec to sets instead of lists. Arrays can be
+	// mapped individually with the `unique_items_as_set` attribute override.
+	UniqueItemsAsSets bool `yaml:"unique_items_as_sets"`
+
+	// IgnoreConstAttributes removes all request and response body attributes with a single allowed value, from `const` or an
+	// `enum` with one value, in the OpenAPI spec. These attributes, like discriminators and API versions, never need to be set in
+	// Terraform. Path and query parameters are still mapped, as they're needed to locate the resource or data source.
+	IgnoreConstAttributes bool `yaml:"ignore_const_attributes"`
+
+	// MultipleOfValidators are validator functions, defined by the provider, that the `multipleOf` of schemas in the OpenAPI spec
+	// are mapped to. The framework validators module has no validators for multiples, so `multipleOf` isn't validated without them.
+	MultipleOfValidators MultipleOfValidators `yaml:"multiple_of_validators"`
+
+	// Defaults are schema options that are merged into the schema options of every resource and data source. Aliases and
+	// overrides defined on a resource or data source take precedence over defaults for the same parameter or attribute location.
+	// Resource-only override options, like plan modifiers, are not applied to data sources.
+	Defaults SchemaOptions `yaml:"defaults"`
+}
+
+// MultipleOfValidators generator config section.
+type MultipleOfValidators struct {
+	// Int64 is called with the `multipleOf` of integer schemas, and must return a validator.Int64.
+	Int64 *ValidatorFunction `yaml:"int64"`
+	// Float64 is called with the `multipleOf` of number schemas with a `double` or `float` format, and must return a
+	// validator.Float64.
+	Float64 *ValidatorFunction `yaml:"float64"`
+	// Number is called with the `multipleOf` of other number schemas, and must return a validator.Number.
+	Number *ValidatorFunction `yaml:"number"`
+}
+
+// ValidatorFunction is a Go function that returns a validator, called with the value of a schema keyword as its argument.
+type ValidatorFunction struct {
+	// Import is the path of the Go package that defines the function, if it isn't in the package of the generated code.
+	Import string `yaml:"import"`
+	// Function is the qualified name of the function, such as `validators.Int64MultipleOf`.
+	Function string `yaml:"function"`
 }
 
 // Provider generator config section.
@@ -51,7 +106,8 @@ type DataSource struct {
 	SchemaOptions SchemaOptions        `yaml:"schema"`
 }
 
-// OpenApiSpecLocation defines a location in an OpenAPI spec for an API operation.
+// OpenApiSpecLocation defines a location in an OpenAPI spec for an API operation. An operation is located by either
+// a path and method, or an operation ID.
 type OpenApiSpecLocation struct {
 	// Matches the path key for a path item (refer to [OAS Paths Object]).
 	//
@@ -61,6 +117,13 @@ type OpenApiSpecLocation struct {
 	//
 	// [OAS Path Item Object]: https://spec.openapis.org/oas/v3.1.0#pathItemObject
 	Method string `yaml:"method"`
+	// Matches the unique operationId of an operation (refer to [OAS Operation Object]). Can't be used with path and method.
+	//
+	// [OAS Operation Object]: https://spec.openapis.org/oas/v3.1.0#operation-object
+	OperationId string `yaml:"operation_id"`
+	// Document is the name of the OpenAPI spec document the operation is defined in, when generating from multiple OpenAPI specs.
+	// The name of a document is its file name without the extension, for example: `pets` for `pets.yml`.
+	Document string `yaml:"document"`
 }
 
 // SchemaOptions generator config section. This section contains options for modifying the output of the generator.
@@ -68,20 +131,95 @@ type SchemaOptions struct {
 	// Ignores are a slice of strings, representing an attribute location to ignore during mapping (dot-separated for nested attributes).
 	Ignores          []string         `yaml:"ignores"`
 	AttributeOptions AttributeOptions `yaml:"attributes"`
+
+	// ExcludeDefaults are ignores, override attribute locations, and alias keys from the `defaults` section of the
+	// generator config that are not applied to this resource or data source.
+	ExcludeDefaults []string `yaml:"exclude_defaults"`
 }
 
 // AttributeOptions generator config section. This section is used to modify the output of specific attributes.
 type AttributeOptions struct {
-	// Aliases are a map, with the key being a parameter name in an OpenAPI operation and the value being the new name (alias).
+	// Aliases are a map, with the key being a parameter name in an OpenAPI operation or a request/response body property location
+	// (dot-separated for nested properties) and the value being the new name (alias). Body properties with the same name as an aliased
+	// parameter aren't aliased.
 	Aliases map[string]string `yaml:"aliases"`
 	// Overrides are a map, with the key being an attribute location (dot-separated for nested attributes) and the value being overrides to apply to the attribute.
 	Overrides map[string]Override `yaml:"overrides"`
 }
 
+// Format generator config section.
+type Format struct {
+	// CustomType overrides the default framework custom type for string attributes with this format. An empty custom type will
+	// map attributes with this format to plain strings.
+	CustomType *CustomType `yaml:"custom_type"`
+}
+
+// CustomType generator config section.
+type CustomType struct {
+	// Import is the Go import path of the package that defines the custom type.
+	Import string `yaml:"import"`
+	// Type is the custom type used in the schema, for example: `timetypes.RFC3339Type{}`.
+	Type string `yaml:"type"`
+	// ValueType is the custom value type used with the schema type, for example: `timetypes.RFC3339`.
+	ValueType string `yaml:"value_type"`
+}
+
 // Override generator config section.
 type Override struct {
 	// Description overrides the description that was mapped/merged from the OpenAPI specification.
 	Description string `yaml:"description"`
+
+	// ComputedOptionalRequired overrides the computability that was mapped/merged from the OpenAPI specification. Must be one of
+	// `computed`, `computed_optional`, `optional`, or `required`.
+	ComputedOptionalRequired string `yaml:"computed_optional_required"`
+
+	// Sensitive marks the attribute as sensitive if true, or removes sensitivity mapped from the OpenAPI specification if false.
+	Sensitive *bool `yaml:"sensitive"`
+
+	// DeprecationMessage overrides the deprecation message that was mapped from the OpenAPI specification.
+	DeprecationMessage string `yaml:"deprecation_message"`
+
+	// Type converts a list attribute to a set, or a set attribute to a list. Must be one of `list` or `set`.
+	Type string `yaml:"type"`
+
+	// Validators are appended to the validators of the attribute.
+	Validators []CustomCode `yaml:"validators"`
+
+	// RemoveValidators removes the validators that were mapped from the OpenAPI specification, before Validators are appended.
+	RemoveValidators bool `yaml:"remove_validators"`
+
+	// PlanModifiers are appended to the plan modifiers of the attribute. Only supported for resources.
+	PlanModifiers []CustomCode `yaml:"plan_modifiers"`
+
+	// Default replaces the default that was mapped from the OpenAPI specification. Only supported for resources.
+	Default *CustomCode `yaml:"default"`
+
+	// RemoveDefault removes the default that was mapped from the OpenAPI specification. Only supported for resources.
+	RemoveDefault bool `yaml:"remove_default"`
+
+	// UniqueItemsAsSet maps an array with `uniqueItems: true` to a set if true, or to a list if false, regardless of the
+	// `unique_items_as_sets` generator config option.
+	UniqueItemsAsSet *bool `yaml:"unique_items_as_set"`
+}
+
+// CustomCode generator config section. This section defines hand-written code for a validator, plan modifier, or default,
+// in the same shape as the custom validators, plan modifiers, and defaults of the provider code specification.
+type CustomCode struct {
+	// Imports are the Go imports needed by the schema definition.
+	Imports []CodeImport `yaml:"imports"`
+
+	// SchemaDefinition is the Go code that creates the validator, plan modifier, or default, for example:
+	// `stringvalidator.LengthAtMost(64)`.
+	SchemaDefinition string `yaml:"schema_definition"`
+}
+
+// CodeImport generator config section.
+type CodeImport struct {
+	// Path is the Go import path, for example: `github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator`.
+	Path string `yaml:"path"`
+
+	// Alias is an optional name for the imported package.
+	Alias string `yaml:"alias"`
 }
 
 // ParseConfig takes in a byte array (of YAML), unmarshals into a Config struct, and validates the result
@@ -102,7 +240,8 @@ func ParseConfig(bytes []byte) (*Config, error) {
 func (c Config) Validate() error {
 	var result error
 
-	if len(c.DataSources) == 0 && len(c.Resources) == 0 {
+	// With spec extensions enabled, resources and data sources can be discovered from the OpenAPI spec instead
+	if !c.SpecExtensions && len(c.DataSources) == 0 && len(c.Resources) == 0 {
 		result = errors.Join(result, errors.New("\tat least one object is required in either 'resources' or 'data_sources'"))
 	}
 
@@ -112,12 +251,27 @@ func (c Config) Validate() error {
 		result = errors.Join(result, fmt.Errorf("\tprovider %w", err))
 	}
 
+	// Validate Defaults
+	err = c.Defaults.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("\tdefaults %w", err))
+	}
+
+	if len(c.Defaults.ExcludeDefaults) > 0 {
+		result = errors.Join(result, errors.New("\tdefaults can't have an 'exclude_defaults' property"))
+	}
+
 	// Validate all Resources
 	for name, resource := range c.Resources {
 		err := resource.Validate()
 		if err != nil {
 			result = errors.Join(result, fmt.Errorf("\tresource '%s' %w", name, err))
 		}
+
+		err = resource.SchemaOptions.validateExcludeDefaults(c.Defaults)
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("\tresource '%s' %w", name, err))
+		}
 	}
 
 	// Validate all Data Sources
@@ -126,6 +280,24 @@ func (c Config) Validate() error {
 		if err != nil {
 			result = errors.Join(result, fmt.Errorf("\tdata_source '%s' %w", name, err))
 		}
+
+		err = dataSource.SchemaOptions.validateExcludeDefaults(c.Defaults)
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("\tdata_source '%s' %w", name, err))
+		}
+	}
+
+	// Validate all Formats
+	for name, format := range c.Formats {
+		err := format.Validate()
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("\tformat '%s' %w", name, err))
+		}
+	}
+
+	err = c.MultipleOfValidators.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("\tmultiple_of_validators %w", err))
 	}
 
 	return result
@@ -147,6 +319,69 @@ func (p Provider) Validate() error {
 	return result
 }
 
+func (f Format) Validate() error {
+	var result error
+
+	err := f.CustomType.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("invalid custom_type: %w", err))
+	}
+
+	return result
+}
+
+func (m MultipleOfValidators) Validate() error {
+	var result error
+
+	err := m.Int64.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("invalid int64: %w", err))
+	}
+
+	err = m.Float64.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("invalid float64: %w", err))
+	}
+
+	err = m.Number.Validate()
+	if err != nil {
+		result = errors.Join(result, fmt.Errorf("invalid number: %w", err))
+	}
+
+	return result
+}
+
+func (v *ValidatorFunction) Validate() error {
+	if v == nil {
+		return nil
+	}
+
+	if v.Function == "" {
+		return errors.New("'function' property is required")
+	}
+
+	return nil
+}
+
+func (c *CustomType) Validate() error {
+	var result error
+
+	// An empty custom type disables the default custom type for a format
+	if c == nil || *c == (CustomType{}) {
+		return nil
+	}
+
+	if c.Type == "" {
+		result = errors.Join(result, errors.New("'type' property is required"))
+	}
+
+	if c.ValueType == "" {
+		result = errors.Join(result, errors.New("'value_type' property is required"))
+	}
+
+	return result
+}
+
 func (r Resource) Validate() error {
 	var result error
 
@@ -202,6 +437,13 @@ func (d DataSource) Validate() error {
 		result = errors.Join(result, fmt.Errorf("invalid schema: %w", err))
 	}
 
+	for path, override := range d.SchemaOptions.AttributeOptions.Overrides {
+		err := override.validateForDataSource()
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("invalid override for %q: %w", path, err))
+		}
+	}
+
 	return result
 }
 
@@ -211,12 +453,20 @@ func (o *OpenApiSpecLocation) Validate() error {
 		return nil
 	}
 
+	if o.OperationId != "" {
+		if o.Path != "" || o.Method != "" {
+			result = errors.Join(result, errors.New("'operation_id' property can't be used with 'path' and 'method' properties"))
+		}
+
+		return result
+	}
+
 	if o.Path == "" {
-		result = errors.Join(result, errors.New("'path' property is required"))
+		result = errors.Join(result, errors.New("'path' property is required, unless 'operation_id' is set"))
 	}
 
 	if o.Method == "" {
-		result = errors.Join(result, errors.New("'method' property is required"))
+		result = errors.Join(result, errors.New("'method' property is required, unless 'operation_id' is set"))
 	}
 
 	return result
@@ -239,13 +489,121 @@ func (s *SchemaOptions) Validate() error {
 	return result
 }
 
+// validateExcludeDefaults checks that every excluded default is an ignore, override attribute location, or alias parameter name
+// in the `defaults` section of the generator config.
+func (s *SchemaOptions) validateExcludeDefaults(defaults SchemaOptions) error {
+	var result error
+
+	for _, exclude := range s.ExcludeDefaults {
+		_, isOverride := defaults.AttributeOptions.Overrides[exclude]
+		_, isAlias := defaults.AttributeOptions.Aliases[exclude]
+
+		if !slices.Contains(defaults.Ignores, exclude) && !isOverride && !isAlias {
+			result = errors.Join(result, fmt.Errorf("invalid item for exclude_defaults: %q - must be an ignore, override, or alias in 'defaults'", exclude))
+		}
+	}
+
+	return result
+}
+
 func (s *AttributeOptions) Validate() error {
 	var result error
 
-	for path := range s.Overrides {
+	for path, override := range s.Overrides {
 		if !attributeLocationRegex.MatchString(path) {
 			result = errors.Join(result, fmt.Errorf("invalid key for override: %q - must be dot-separated string", path))
 		}
+
+		err := override.Validate()
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("invalid override for %q: %w", path, err))
+		}
+	}
+
+	return result
+}
+
+// validateForDataSource checks that the override only contains options that are supported by data source attributes.
+func (o *Override) validateForDataSource() error {
+	var result error
+
+	if len(o.PlanModifiers) > 0 {
+		result = errors.Join(result, errors.New("'plan_modifiers' property is only supported for resources"))
+	}
+
+	if o.Default != nil || o.RemoveDefault {
+		result = errors.Join(result, errors.New("'default' and 'remove_default' properties are only supported for resources"))
+	}
+
+	return result
+}
+
+func (c *CustomCode) Validate() error {
+	var result error
+
+	if c.SchemaDefinition == "" {
+		result = errors.Join(result, errors.New("'schema_definition' property is required"))
+	}
+
+	for i, codeImport := range c.Imports {
+		if codeImport.Path == "" {
+			result = errors.Join(result, fmt.Errorf("'path' property is required for import at index %d", i))
+		}
+	}
+
+	return result
+}
+
+func (o *Override) Validate() error {
+	var result error
+
+	switch o.ComputedOptionalRequired {
+	case "", "computed", "computed_optional", "optional", "required":
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid computed_optional_required: %q - must be one of 'computed', 'computed_optional', 'optional', or 'required'", o.ComputedOptionalRequired))
+	}
+
+	switch o.Type {
+	case "", "list", "set":
+	default:
+		result = errors.Join(result, fmt.Errorf("invalid type: %q - must be one of 'list' or 'set'", o.Type))
+	}
+
+	if o.Type == "list" && o.UniqueItemsAsSet != nil && *o.UniqueItemsAsSet {
+		result = errors.Join(result, errors.New("'type' property can't be 'list' when 'unique_items_as_set' is true"))
+	}
+
+	if o.Type == "set" && o.UniqueItemsAsSet != nil && !*o.UniqueItemsAsSet {
+		result = errors.Join(result, errors.New("'type' property can't be 'set' when 'unique_items_as_set' is false"))
+	}
+
+	for i, validator := range o.Validators {
+		err := validator.Validate()
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("invalid validator at index %d: %w", i, err))
+		}
+	}
+
+	for i, planModifier := range o.PlanModifiers {
+		err := planModifier.Validate()
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("invalid plan modifier at index %d: %w", i, err))
+		}
+	}
+
+	if o.Default != nil {
+		err := o.Default.Validate()
+		if err != nil {
+			result = errors.Join(result, fmt.Errorf("invalid default: %w", err))
+		}
+
+		if o.RemoveDefault {
+			result = errors.Join(result, errors.New("'default' property can't be used with 'remove_default' property"))
+		}
+
+		if o.ComputedOptionalRequired == "optional" || o.ComputedOptionalRequired == "required" {
+			result = errors.Join(result, fmt.Errorf("'default' property can't be used when 'computed_optional_required' is %q", o.ComputedOptionalRequired))
+		}
 	}
 
 	return result
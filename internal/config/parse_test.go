@@ -29,6 +29,34 @@ resources:
     read:
       path: /example/path/to/thing/{id}
       method: GET`,
+		},
+		"valid resource with documents": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+      document: things
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+      document: things`,
+		},
+		"valid resource with operation_id": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      operation_id: createThing
+    read:
+      operation_id: getThing`,
 		},
 		"valid resource with parameter matches": {
 			input: `
@@ -70,6 +98,146 @@ resources:
             description: Here is a test description for the 'there' property in 'hey'
           "hey.there.nested.thing":
             description: Deeply nested property 'thing'`,
+		},
+		"valid resource with attribute overrides": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            computed_optional_required: computed_optional
+            sensitive: true
+            deprecation_message: Use 'there' instead
+          "hey.there":
+            computed_optional_required: required
+            type: set`,
+		},
+		"valid defaults": {
+			input: `
+provider:
+  name: example
+
+defaults:
+  ignores:
+    - "**._links"
+    - "**.etag"
+  attributes:
+    aliases:
+      projectId: project
+    overrides:
+      "**.created_at":
+        description: The time the object was created.
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      exclude_defaults:
+        - "**.etag"
+        - projectId
+
+data_sources:
+  thing:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      exclude_defaults:
+        - "**.created_at"`,
+		},
+		"valid resource with location patterns": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      ignores:
+        - "**._links"
+        - "*.etag"
+        - "created_*"
+      attributes:
+        overrides:
+          "tags.*":
+            description: A tag`,
+		},
+		"valid resource with custom code overrides": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          name:
+            remove_validators: true
+            validators:
+              - schema_definition: stringvalidator.LengthAtMost(64)
+                imports:
+                  - path: github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator
+            plan_modifiers:
+              - schema_definition: stringplanmodifier.RequiresReplace()
+                imports:
+                  - path: github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier
+          "hey.there":
+            default:
+              schema_definition: int64default.StaticInt64(1)
+              imports:
+                - path: github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default
+          "hey.other":
+            remove_default: true`,
+		},
+		"valid resource with unique items as sets": {
+			input: `
+provider:
+  name: example
+
+unique_items_as_sets: true
+
+resources:
+  thing:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          "hey.ordered_list":
+            unique_items_as_set: false`,
 		},
 		"valid resource with ignores": {
 			input: `
@@ -184,6 +352,54 @@ data_sources:
       path: /example/path/to/thing/{id}
       method: GET`,
 		},
+		"valid formats": {
+			input: `
+provider:
+  name: example
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+formats:
+  uuid:
+    custom_type:
+      import: github.com/example/uuidtypes
+      type: uuidtypes.UUIDType{}
+      value_type: uuidtypes.UUID
+  date-time:
+    custom_type: {}`,
+		},
+		"valid multiple_of_validators": {
+			input: `
+provider:
+  name: example
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+multiple_of_validators:
+  int64:
+    import: github.com/example/validators
+    function: validators.Int64MultipleOf
+  float64:
+    function: validators.Float64MultipleOf
+  number:
+    import: github.com/example/validators
+    function: validators.NumberMultipleOf`,
+		},
+		"valid spec extensions without resources or data sources": {
+			input: `
+provider:
+  name: example
+
+spec_extensions: true`,
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -257,6 +473,21 @@ resources:
       method: POST`,
 			expectedErrRegex: `resource 'thing_one' must have a read object`,
 		},
+		"resource - invalid create - operation_id with path and method": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      operation_id: createThing
+      path: /example/path/to/things
+      method: POST
+    read:
+      operation_id: getThing`,
+			expectedErrRegex: `invalid create: 'operation_id' property can't be used with 'path' and 'method' properties`,
+		},
 		"resource - invalid create - path required": {
 			input: `
 provider:
@@ -365,6 +596,133 @@ resources:
             description: Here is a test description for the 'hey' property`,
 			expectedErrRegex: `invalid key for override: \".hey\"`,
 		},
+		"resource - invalid override computed_optional_required": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            computed_optional_required: optional_computed`,
+			expectedErrRegex: `invalid override for \"hey\": invalid computed_optional_required: \"optional_computed\"`,
+		},
+		"resource - invalid override type": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            type: map`,
+			expectedErrRegex: `invalid override for \"hey\": invalid type: \"map\"`,
+		},
+		"resource - conflicting override type and unique_items_as_set": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            type: list
+            unique_items_as_set: true`,
+			expectedErrRegex: `'type' property can't be 'list' when 'unique_items_as_set' is true`,
+		},
+		"resource - invalid override validator": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            validators:
+              - imports:
+                  - alias: validators`,
+			expectedErrRegex: `invalid validator at index 0: 'schema_definition' property is required\n'path' property is required for import at index 0`,
+		},
+		"resource - override default with remove_default": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            remove_default: true
+            default:
+              schema_definition: stringdefault.StaticString("hey")`,
+			expectedErrRegex: `'default' property can't be used with 'remove_default' property`,
+		},
+		"resource - override default with required": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            computed_optional_required: required
+            default:
+              schema_definition: stringdefault.StaticString("hey")`,
+			expectedErrRegex: `'default' property can't be used when 'computed_optional_required' is "required"`,
+		},
 		"resource - invalid ignore item": {
 			input: `
 provider:
@@ -383,6 +741,122 @@ resources:
         - .invalid.ignore.`,
 			expectedErrRegex: `invalid item for ignores: \".invalid.ignore.\"`,
 		},
+		"resource - invalid ignore pattern": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      ignores:
+        - "tags.**"`,
+			expectedErrRegex: `invalid item for ignores: \"tags.\*\*\"`,
+		},
+		"resource - invalid recursive wildcard in ignore": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      ignores:
+        - "***.etag"`,
+			expectedErrRegex: `invalid item for ignores: \"\*\*\*.etag\"`,
+		},
+		"resource - invalid wildcard within override key": {
+			input: `
+provider:
+  name: example
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          "created**at":
+            description: Creation time`,
+			expectedErrRegex: `invalid key for override: \"created\*\*at\"`,
+		},
+		"defaults - invalid ignore item": {
+			input: `
+provider:
+  name: example
+
+defaults:
+  ignores:
+    - .invalid.ignore.
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `defaults invalid item for ignores: \".invalid.ignore.\"`,
+		},
+		"defaults - exclude_defaults": {
+			input: `
+provider:
+  name: example
+
+defaults:
+  exclude_defaults:
+    - etag
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET`,
+			expectedErrRegex: `defaults can't have an 'exclude_defaults' property`,
+		},
+		"resource - invalid exclude_defaults item": {
+			input: `
+provider:
+  name: example
+
+defaults:
+  ignores:
+    - etag
+
+resources:
+  thing_one:
+    create:
+      path: /example/path/to/things
+      method: POST
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      exclude_defaults:
+        - etga`,
+			expectedErrRegex: `resource 'thing_one' invalid item for exclude_defaults: \"etga\" - must be an ignore, override, or alias in 'defaults'`,
+		},
 		"data source - read required": {
 			input: `
 provider:
@@ -431,6 +905,59 @@ data_sources:
             description: Here is a test description for the 'hey' property`,
 			expectedErrRegex: `invalid key for override: \"hey.\"`,
 		},
+		"format - invalid custom_type - type and value_type required": {
+			input: `
+provider:
+  name: example
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+formats:
+  uuid:
+    custom_type:
+      import: github.com/example/uuidtypes`,
+			expectedErrRegex: `format 'uuid' invalid custom_type: 'type' property is required\n'value_type' property is required`,
+		},
+		"multiple_of_validators - function required": {
+			input: `
+provider:
+  name: example
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+
+multiple_of_validators:
+  int64:
+    import: github.com/example/validators
+  number:
+    import: github.com/example/validators`,
+			expectedErrRegex: `multiple_of_validators invalid int64: 'function' property is required\ninvalid number: 'function' property is required`,
+		},
+		"data source - override plan modifiers": {
+			input: `
+provider:
+  name: example
+
+data_sources:
+  thing_one:
+    read:
+      path: /example/path/to/thing/{id}
+      method: GET
+    schema:
+      attributes:
+        overrides:
+          hey:
+            plan_modifiers:
+              - schema_definition: stringplanmodifier.RequiresReplace()`,
+			expectedErrRegex: `invalid override for \"hey\": 'plan_modifiers' property is only supported for resources`,
+		},
 		"data source - invalid ignore item": {
 			input: `
 provider:
@@ -10,6 +10,8 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
 	highbase "github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
@@ -81,7 +83,13 @@ func (e configExplorer) FindResources() (map[string]Resource, error) {
 			continue
 		}
 
-		commonParameters, err := extractCommonParameters(e.spec.Paths, resourceConfig.Read.Path)
+		readPath, err := extractPath(e.spec.Paths, resourceConfig.Read)
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.read' path: %w", name, err))
+			continue
+		}
+
+		commonParameters, err := extractCommonParameters(e.spec.Paths, readPath)
 		if err != nil {
 			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s' common parameters: %w", name, err))
 			continue
@@ -111,7 +119,13 @@ func (e configExplorer) FindDataSources() (map[string]DataSource, error) {
 			continue
 		}
 
-		commonParameters, err := extractCommonParameters(e.spec.Paths, dataSourceConfig.Read.Path)
+		readPath, err := extractPath(e.spec.Paths, dataSourceConfig.Read)
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s.read' path: %w", name, err))
+			continue
+		}
+
+		commonParameters, err := extractCommonParameters(e.spec.Paths, readPath)
 		if err != nil {
 			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s' common parameters: %w", name, err))
 			continue
@@ -132,6 +146,11 @@ func extractOp(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) (*hig
 		return nil, nil
 	}
 
+	if oasLocation.OperationId != "" {
+		_, op, err := findOperationById(paths, oasLocation.OperationId)
+		return op, err
+	}
+
 	if paths == nil || paths.PathItems == nil || paths.PathItems.GetOrZero(oasLocation.Path) == nil {
 		return nil, fmt.Errorf("path '%s' not found in OpenAPI spec", oasLocation.Path)
 	}
@@ -160,6 +179,16 @@ func extractOp(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) (*hig
 	}
 }
 
+// extractPath returns the path of the operation, which is found in the OpenAPI spec if the operation is located by operation ID.
+func extractPath(paths *high.Paths, oasLocation *config.OpenApiSpecLocation) (string, error) {
+	if oasLocation.OperationId == "" {
+		return oasLocation.Path, nil
+	}
+
+	path, _, err := findOperationById(paths, oasLocation.OperationId)
+	return path, err
+}
+
 func extractCommonParameters(paths *high.Paths, path string) ([]*high.Parameter, error) {
 	// No need to search OAS if not defined
 	if paths.PathItems.GetOrZero(path) == nil {
@@ -198,6 +227,12 @@ func extractSchemaProxy(document high.Document, componentRef string) (*highbase.
 	return highbase.CreateSchemaProxy(highSchema), nil
 }
 
+// SchemaDefaults returns the schema options from the `defaults` section of the generator config, which are merged into the
+// schema options of every resource and data source with SchemaOptions.WithDefaults.
+func SchemaDefaults(cfg config.Config) SchemaOptions {
+	return extractSchemaOptions(cfg.Defaults)
+}
+
 func extractSchemaOptions(cfgSchemaOpts config.SchemaOptions) SchemaOptions {
 	return SchemaOptions{
 		Ignores: cfgSchemaOpts.Ignores,
@@ -205,14 +240,80 @@ func extractSchemaOptions(cfgSchemaOpts config.SchemaOptions) SchemaOptions {
 			Aliases:   cfgSchemaOpts.AttributeOptions.Aliases,
 			Overrides: extractOverrides(cfgSchemaOpts.AttributeOptions.Overrides),
 		},
+		ExcludeDefaults: cfgSchemaOpts.ExcludeDefaults,
 	}
 }
 
 func extractOverrides(cfgOverrides map[string]config.Override) map[string]Override {
 	overrides := make(map[string]Override, len(cfgOverrides))
 	for key, cfgOverride := range cfgOverrides {
-		overrides[key] = Override{Description: cfgOverride.Description}
+		overrides[key] = Override{
+			Description:              cfgOverride.Description,
+			ComputedOptionalRequired: schema.ComputedOptionalRequired(cfgOverride.ComputedOptionalRequired),
+			Sensitive:                cfgOverride.Sensitive,
+			DeprecationMessage:       cfgOverride.DeprecationMessage,
+			Type:                     cfgOverride.Type,
+			UniqueItemsAsSet:         cfgOverride.UniqueItemsAsSet,
+			Validators:               extractCustomValidators(cfgOverride.Validators),
+			RemoveValidators:         cfgOverride.RemoveValidators,
+			PlanModifiers:            extractCustomPlanModifiers(cfgOverride.PlanModifiers),
+			Default:                  extractCustomDefault(cfgOverride.Default),
+			RemoveDefault:            cfgOverride.RemoveDefault,
+		}
 	}
 
 	return overrides
 }
+
+func extractCustomValidators(cfgValidators []config.CustomCode) []*schema.CustomValidator {
+	var validators []*schema.CustomValidator
+	for _, cfgValidator := range cfgValidators {
+		validators = append(validators, &schema.CustomValidator{
+			Imports:          extractCodeImports(cfgValidator.Imports),
+			SchemaDefinition: cfgValidator.SchemaDefinition,
+		})
+	}
+
+	return validators
+}
+
+func extractCustomPlanModifiers(cfgPlanModifiers []config.CustomCode) []*schema.CustomPlanModifier {
+	var planModifiers []*schema.CustomPlanModifier
+	for _, cfgPlanModifier := range cfgPlanModifiers {
+		planModifiers = append(planModifiers, &schema.CustomPlanModifier{
+			Imports:          extractCodeImports(cfgPlanModifier.Imports),
+			SchemaDefinition: cfgPlanModifier.SchemaDefinition,
+		})
+	}
+
+	return planModifiers
+}
+
+func extractCustomDefault(cfgDefault *config.CustomCode) *schema.CustomDefault {
+	if cfgDefault == nil {
+		return nil
+	}
+
+	return &schema.CustomDefault{
+		Imports:          extractCodeImports(cfgDefault.Imports),
+		SchemaDefinition: cfgDefault.SchemaDefinition,
+	}
+}
+
+func extractCodeImports(cfgImports []config.CodeImport) []code.Import {
+	var imports []code.Import
+	for _, cfgImport := range cfgImports {
+		codeImport := code.Import{
+			Path: cfgImport.Path,
+		}
+
+		if cfgImport.Alias != "" {
+			alias := cfgImport.Alias
+			codeImport.Alias = &alias
+		}
+
+		imports = append(imports, codeImport)
+	}
+
+	return imports
+}
@@ -12,6 +12,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -24,6 +26,8 @@ import (
 func Test_ConfigExplorer_FindResources(t *testing.T) {
 	t.Parallel()
 
+	setValidatorsAlias := "setvalidators"
+
 	testCases := map[string]struct {
 		pathItems   *orderedmap.Map[string, *high.PathItem]
 		config      config.Config
@@ -251,6 +255,128 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 			}),
 			expectedErr: errors.New(`failed to extract 'test_resource.update': method 'FAKE' not found at OpenAPI path '/resources/{resource_id}'`),
 		},
+		"valid CRUD ops by operation_id": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							OperationId: "create_resource",
+						},
+						Read: &config.OpenApiSpecLocation{
+							OperationId: "read_resource",
+						},
+						Delete: &config.OpenApiSpecLocation{
+							OperationId: "delete_resource",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+				},
+				"/v2/resources/{resource_id}": {
+					Parameters: []*high.Parameter{
+						{
+							Name: "resource_id",
+							In:   "path",
+						},
+					},
+					Get: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					Delete: &high.Operation{
+						Description: "delete op here",
+						OperationId: "delete_resource",
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						Description: "create op here",
+						OperationId: "create_resource",
+					},
+					ReadOp: &high.Operation{
+						Description: "read op here",
+						OperationId: "read_resource",
+					},
+					DeleteOp: &high.Operation{
+						Description: "delete op here",
+						OperationId: "delete_resource",
+					},
+					CommonParameters: []*high.Parameter{
+						{
+							Name: "resource_id",
+							In:   "path",
+						},
+					},
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{},
+						},
+					},
+				},
+			},
+		},
+		"non-existent operation_id throws error with similar operation IDs": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							OperationId: "createResource",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Get: &high.Operation{
+						OperationId: "listResources",
+					},
+					Post: &high.Operation{
+						OperationId: "createResources",
+					},
+				},
+				"/things": {
+					Post: &high.Operation{
+						OperationId: "CreateResourceV2",
+					},
+					Put: &high.Operation{
+						OperationId: "updateThing",
+					},
+				},
+			}),
+			expectedErr: errors.New(`failed to extract 'test_resource.create': operation_id 'createResource' not found in OpenAPI spec, similar operation IDs: 'createResources', 'CreateResourceV2'`),
+		},
+		"duplicate operation_id throws error": {
+			config: config.Config{
+				Resources: map[string]config.Resource{
+					"test_resource": {
+						Create: &config.OpenApiSpecLocation{
+							OperationId: "create_resource",
+						},
+					},
+				},
+			},
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						OperationId: "create_resource",
+					},
+				},
+				"/v2/resources": {
+					Post: &high.Operation{
+						OperationId: "create_resource",
+					},
+				},
+			}),
+			expectedErr: errors.New(`failed to extract 'test_resource.create': operation_id 'create_resource' is not unique in OpenAPI spec, found in operations: POST /resources, POST /v2/resources`),
+		},
 		"schema options pass-through": {
 			config: config.Config{
 				Resources: map[string]config.Resource{
@@ -273,6 +399,23 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 									"test": {
 										Description: "test description for override",
 									},
+									"test_list": {
+										ComputedOptionalRequired: "computed_optional",
+										DeprecationMessage:       "test deprecation message for override",
+										Type:                     "set",
+										Validators: []config.CustomCode{
+											{
+												Imports: []config.CodeImport{
+													{
+														Path:  "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+														Alias: "setvalidators",
+													},
+												},
+												SchemaDefinition: "setvalidators.SizeAtMost(5)",
+											},
+										},
+										RemoveDefault: true,
+									},
 								},
 							},
 						},
@@ -313,6 +456,23 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 								"test": {
 									Description: "test description for override",
 								},
+								"test_list": {
+									ComputedOptionalRequired: schema.ComputedOptional,
+									DeprecationMessage:       "test deprecation message for override",
+									Type:                     "set",
+									Validators: []*schema.CustomValidator{
+										{
+											Imports: []code.Import{
+												{
+													Path:  "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+													Alias: &setValidatorsAlias,
+												},
+											},
+											SchemaDefinition: "setvalidators.SizeAtMost(5)",
+										},
+									},
+									RemoveDefault: true,
+								},
 							},
 						},
 					},
@@ -341,7 +501,7 @@ func Test_ConfigExplorer_FindResources(t *testing.T) {
 				return
 			}
 
-			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(high.Operation{})); testCase.expectedErr == nil && diff != "" {
+			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(high.Operation{}, high.Parameter{})); testCase.expectedErr == nil && diff != "" {
 				t.Errorf("unexpected difference: %s", diff)
 			}
 		})
@@ -4,6 +4,8 @@
 package explorer
 
 import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 )
@@ -42,6 +44,7 @@ type Provider struct {
 type SchemaOptions struct {
 	Ignores          []string
 	AttributeOptions AttributeOptions
+	ExcludeDefaults  []string
 }
 
 type AttributeOptions struct {
@@ -50,5 +53,15 @@ type AttributeOptions struct {
 }
 
 type Override struct {
-	Description string
+	Description              string
+	ComputedOptionalRequired schema.ComputedOptionalRequired
+	Sensitive                *bool
+	DeprecationMessage       string
+	Type                     string
+	UniqueItemsAsSet         *bool
+	Validators               []*schema.CustomValidator
+	RemoveValidators         bool
+	PlanModifiers            []*schema.CustomPlanModifier
+	Default                  *schema.CustomDefault
+	RemoveDefault            bool
 }
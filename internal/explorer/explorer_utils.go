@@ -3,7 +3,18 @@
 
 package explorer
 
-import high "github.com/pb33f/libopenapi/datamodel/high/v3"
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/suggest"
+)
 
 func mergeParameters(commonParameters []*high.Parameter, operation *high.Operation) []*high.Parameter {
 	mergedParameters := make([]*high.Parameter, len(commonParameters))
@@ -33,3 +44,61 @@ func (e *Resource) ReadOpParameters() []*high.Parameter {
 func (e *DataSource) ReadOpParameters() []*high.Parameter {
 	return mergeParameters(e.CommonParameters, e.ReadOp)
 }
+
+// iterateOperations calls the provided function for every operation in the OpenAPI spec, in document order. All errors returned
+// from the function are joined and returned after all operations have been visited.
+func iterateOperations(paths *high.Paths, fn func(path string, method string, op *high.Operation) error) error {
+	var errResult error
+
+	if paths == nil || paths.PathItems == nil {
+		return nil
+	}
+
+	for pathPair := range orderedmap.Iterate(context.TODO(), paths.PathItems) {
+		for opPair := range orderedmap.Iterate(context.TODO(), pathPair.Value().GetOperations()) {
+			err := fn(pathPair.Key(), strings.ToUpper(opPair.Key()), opPair.Value())
+			if err != nil {
+				errResult = errors.Join(errResult, err)
+			}
+		}
+	}
+
+	return errResult
+}
+
+// findOperationById returns the path and operation with the matching operationId. If the operationId isn't found, the error
+// will include similar operation IDs from the OpenAPI spec.
+func findOperationById(paths *high.Paths, operationId string) (string, *high.Operation, error) {
+	operationIds := []string{}
+	matches := []string{}
+	var foundPath string
+	var foundOp *high.Operation
+
+	_ = iterateOperations(paths, func(path string, method string, op *high.Operation) error {
+		if op.OperationId == "" {
+			return nil
+		}
+
+		operationIds = append(operationIds, op.OperationId)
+		if op.OperationId == operationId {
+			matches = append(matches, fmt.Sprintf("%s %s", method, path))
+			foundPath, foundOp = path, op
+		}
+
+		return nil
+	})
+
+	switch len(matches) {
+	case 1:
+		return foundPath, foundOp, nil
+	case 0:
+		similar := suggest.Similar(operationIds, operationId)
+		if len(similar) == 0 {
+			return "", nil, fmt.Errorf("operation_id '%s' not found in OpenAPI spec", operationId)
+		}
+		return "", nil, fmt.Errorf("operation_id '%s' not found in OpenAPI spec, similar operation IDs: '%s'", operationId, strings.Join(similar, "', '"))
+	default:
+		slices.Sort(matches)
+		return "", nil, fmt.Errorf("operation_id '%s' is not unique in OpenAPI spec, found in operations: %s", operationId, strings.Join(matches, ", "))
+	}
+}
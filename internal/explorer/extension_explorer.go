@@ -0,0 +1,203 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// Operation extension that contains the name of the resource the operation belongs to.
+	extensionResource = "x-terraform-resource"
+	// Operation extension that contains the resource action (create, read, update, delete) the operation maps to.
+	extensionOperation = "x-terraform-operation"
+	// Operation extension that contains the name of the data source the operation is the read operation for.
+	extensionDataSource = "x-terraform-data-source"
+	// Schema extension, in components, that contains the name of the provider the schema is the provider schema for.
+	extensionProvider = "x-terraform-provider"
+
+	operationCreate = "create"
+	operationRead   = "read"
+	operationUpdate = "update"
+	operationDelete = "delete"
+)
+
+var _ Explorer = extensionExplorer{}
+
+type extensionExplorer struct {
+	spec high.Document
+}
+
+// extensionResourceOperations contains all operations found for a resource, as well as the path of the read operation.
+type extensionResourceOperations struct {
+	Ops      map[string]*high.Operation
+	ReadPath string
+}
+
+// An ExtensionExplorer will use vendor extensions in a provided OpenAPIv3 spec to identify resource and data source operations,
+// as well as the provider schema. The following extensions are supported:
+//   - x-terraform-resource + x-terraform-operation: On an operation, identifies the resource name and the action (create, read, update, delete)
+//   - x-terraform-data-source: On an operation, identifies the data source name that the operation is the read operation for
+//   - x-terraform-provider: On a schema in components, identifies the provider name and the provider schema
+func NewExtensionExplorer(spec high.Document) Explorer {
+	return extensionExplorer{
+		spec: spec,
+	}
+}
+
+func (e extensionExplorer) FindProvider() (Provider, error) {
+	foundProvider := Provider{}
+
+	if e.spec.Components == nil || e.spec.Components.Schemas == nil {
+		return foundProvider, nil
+	}
+
+	for pair := range orderedmap.Iterate(context.TODO(), e.spec.Components.Schemas) {
+		schema := pair.Value().Schema()
+		if schema == nil {
+			continue
+		}
+
+		providerName, ok := getExtensionValue(schema.Extensions, extensionProvider)
+		if !ok {
+			continue
+		}
+
+		if foundProvider.SchemaProxy != nil {
+			return Provider{}, fmt.Errorf("found multiple schemas with '%s' extension: '%s' and '%s'", extensionProvider, foundProvider.Name, providerName)
+		}
+
+		foundProvider.Name = providerName
+		foundProvider.SchemaProxy = pair.Value()
+	}
+
+	return foundProvider, nil
+}
+
+func (e extensionExplorer) FindResources() (map[string]Resource, error) {
+	resources := map[string]Resource{}
+	var errResult error
+
+	foundOperations := map[string]*extensionResourceOperations{}
+	err := iterateOperations(e.spec.Paths, func(path string, method string, op *high.Operation) error {
+		name, ok := getExtensionValue(op.Extensions, extensionResource)
+		if !ok {
+			return nil
+		}
+
+		action, ok := getExtensionValue(op.Extensions, extensionOperation)
+		if !ok {
+			return fmt.Errorf("operation '%s %s' for '%s' is missing the '%s' extension", method, path, name, extensionOperation)
+		}
+
+		action = strings.ToLower(action)
+		switch action {
+		case operationCreate, operationRead, operationUpdate, operationDelete:
+		default:
+			return fmt.Errorf("operation '%s %s' for '%s' has an invalid '%s' extension: %q - must be one of [%s %s %s %s]",
+				method, path, name, extensionOperation, action, operationCreate, operationRead, operationUpdate, operationDelete)
+		}
+
+		resourceOps, ok := foundOperations[name]
+		if !ok {
+			resourceOps = &extensionResourceOperations{Ops: map[string]*high.Operation{}}
+			foundOperations[name] = resourceOps
+		}
+
+		if _, ok := resourceOps.Ops[action]; ok {
+			return fmt.Errorf("'%s.%s' is defined by multiple operations, found duplicate at '%s %s'", name, action, method, path)
+		}
+
+		resourceOps.Ops[action] = op
+		if action == operationRead {
+			resourceOps.ReadPath = path
+		}
+
+		return nil
+	})
+	if err != nil {
+		errResult = errors.Join(errResult, err)
+	}
+
+	for name, resourceOps := range foundOperations {
+		if resourceOps.Ops[operationCreate] == nil {
+			errResult = errors.Join(errResult, fmt.Errorf("resource '%s' must have a create operation", name))
+			continue
+		}
+		if resourceOps.Ops[operationRead] == nil {
+			errResult = errors.Join(errResult, fmt.Errorf("resource '%s' must have a read operation", name))
+			continue
+		}
+
+		commonParameters, err := extractCommonParameters(e.spec.Paths, resourceOps.ReadPath)
+		if err != nil {
+			errResult = errors.Join(errResult, fmt.Errorf("failed to extract '%s' common parameters: %w", name, err))
+			continue
+		}
+
+		resources[name] = Resource{
+			CreateOp:         resourceOps.Ops[operationCreate],
+			ReadOp:           resourceOps.Ops[operationRead],
+			UpdateOp:         resourceOps.Ops[operationUpdate],
+			DeleteOp:         resourceOps.Ops[operationDelete],
+			CommonParameters: commonParameters,
+		}
+	}
+
+	return resources, errResult
+}
+
+func (e extensionExplorer) FindDataSources() (map[string]DataSource, error) {
+	dataSources := map[string]DataSource{}
+	var errResult error
+
+	err := iterateOperations(e.spec.Paths, func(path string, method string, op *high.Operation) error {
+		name, ok := getExtensionValue(op.Extensions, extensionDataSource)
+		if !ok {
+			return nil
+		}
+
+		if _, ok := dataSources[name]; ok {
+			return fmt.Errorf("'%s.read' is defined by multiple operations, found duplicate at '%s %s'", name, method, path)
+		}
+
+		commonParameters, err := extractCommonParameters(e.spec.Paths, path)
+		if err != nil {
+			return fmt.Errorf("failed to extract '%s' common parameters: %w", name, err)
+		}
+
+		dataSources[name] = DataSource{
+			ReadOp:           op,
+			CommonParameters: commonParameters,
+		}
+
+		return nil
+	})
+	if err != nil {
+		errResult = errors.Join(errResult, err)
+	}
+
+	return dataSources, errResult
+}
+
+// getExtensionValue returns the string value of a vendor extension, if it exists and is a non-empty scalar.
+func getExtensionValue(extensions *orderedmap.Map[string, *yaml.Node], key string) (string, bool) {
+	if extensions == nil {
+		return "", false
+	}
+
+	node, ok := extensions.Get(key)
+	if !ok || node == nil || node.Kind != yaml.ScalarNode || node.Value == "" {
+		return "", false
+	}
+
+	return node.Value, true
+}
@@ -0,0 +1,364 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer_test
+
+import (
+	"regexp"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+func Test_ExtensionExplorer_FindResources(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pathItems        *orderedmap.Map[string, *high.PathItem]
+		want             map[string]explorer.Resource
+		expectedErrRegex string
+	}{
+		"valid CRUD ops": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						OperationId: "create_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "create"),
+					},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						OperationId: "read_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "read"),
+					},
+					Put: &high.Operation{
+						OperationId: "update_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "UPDATE"),
+					},
+					Delete: &high.Operation{
+						OperationId: "delete_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "delete"),
+					},
+					Parameters: []*high.Parameter{
+						{Name: "resource_id", In: "path"},
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						OperationId: "create_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "create"),
+					},
+					ReadOp: &high.Operation{
+						OperationId: "read_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "read"),
+					},
+					UpdateOp: &high.Operation{
+						OperationId: "update_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "UPDATE"),
+					},
+					DeleteOp: &high.Operation{
+						OperationId: "delete_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "delete"),
+					},
+					CommonParameters: []*high.Parameter{
+						{Name: "resource_id", In: "path"},
+					},
+				},
+			},
+		},
+		"operations without extensions are skipped": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						OperationId: "create_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "create"),
+					},
+					Get: &high.Operation{
+						OperationId: "list_resources",
+					},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						OperationId: "read_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "read"),
+					},
+				},
+			}),
+			want: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: &high.Operation{
+						OperationId: "create_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "create"),
+					},
+					ReadOp: &high.Operation{
+						OperationId: "read_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "read"),
+					},
+				},
+			},
+		},
+		"missing operation extension": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Extensions: extensions("x-terraform-resource", "test_resource"),
+					},
+				},
+			}),
+			want:             map[string]explorer.Resource{},
+			expectedErrRegex: `operation 'POST /resources' for 'test_resource' is missing the 'x-terraform-operation' extension`,
+		},
+		"invalid operation extension": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Extensions: extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "list"),
+					},
+				},
+			}),
+			want:             map[string]explorer.Resource{},
+			expectedErrRegex: `invalid 'x-terraform-operation' extension: "list"`,
+		},
+		"duplicate operation": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Extensions: extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "create"),
+					},
+					Put: &high.Operation{
+						Extensions: extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "create"),
+					},
+				},
+			}),
+			want:             map[string]explorer.Resource{},
+			expectedErrRegex: `'test_resource.create' is defined by multiple operations, found duplicate at '(PUT|POST) /resources'`,
+		},
+		"missing read operation": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						Extensions: extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "create"),
+					},
+				},
+			}),
+			want:             map[string]explorer.Resource{},
+			expectedErrRegex: `resource 'test_resource' must have a read operation`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			extensionExplorer := explorer.NewExtensionExplorer(high.Document{Paths: &high.Paths{PathItems: testCase.pathItems}})
+			got, err := extensionExplorer.FindResources()
+
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedErrRegex)
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %s", testCase.expectedErrRegex, err)
+				}
+			} else if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(high.Operation{}, high.Parameter{}), cmpopts.IgnoreFields(high.Operation{}, "Extensions")); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_ExtensionExplorer_FindDataSources(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pathItems        *orderedmap.Map[string, *high.PathItem]
+		want             map[string]explorer.DataSource
+		expectedErrRegex string
+	}{
+		"valid read op": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						OperationId: "read_resource",
+						Extensions:  extensions("x-terraform-data-source", "test_data_source"),
+					},
+					Parameters: []*high.Parameter{
+						{Name: "resource_id", In: "path"},
+					},
+				},
+				"/resources": {
+					Get: &high.Operation{
+						OperationId: "list_resources",
+					},
+				},
+			}),
+			want: map[string]explorer.DataSource{
+				"test_data_source": {
+					ReadOp: &high.Operation{
+						OperationId: "read_resource",
+						Extensions:  extensions("x-terraform-data-source", "test_data_source"),
+					},
+					CommonParameters: []*high.Parameter{
+						{Name: "resource_id", In: "path"},
+					},
+				},
+			},
+		},
+		"duplicate read op": {
+			pathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						Extensions: extensions("x-terraform-data-source", "test_data_source"),
+					},
+					Head: &high.Operation{
+						Extensions: extensions("x-terraform-data-source", "test_data_source"),
+					},
+				},
+			}),
+			want: map[string]explorer.DataSource{
+				"test_data_source": {
+					ReadOp: &high.Operation{
+						Extensions: extensions("x-terraform-data-source", "test_data_source"),
+					},
+				},
+			},
+			expectedErrRegex: `'test_data_source.read' is defined by multiple operations, found duplicate at 'HEAD /resources/{resource_id}'`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			extensionExplorer := explorer.NewExtensionExplorer(high.Document{Paths: &high.Paths{PathItems: testCase.pathItems}})
+			got, err := extensionExplorer.FindDataSources()
+
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedErrRegex)
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %s", testCase.expectedErrRegex, err)
+				}
+			} else if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.want, cmpopts.IgnoreUnexported(high.Operation{}, high.Parameter{}), cmpopts.IgnoreFields(high.Operation{}, "Extensions")); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func Test_ExtensionExplorer_FindProvider(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemas             *orderedmap.Map[string, *base.SchemaProxy]
+		expectedName        string
+		expectedDescription string
+		expectedErrRegex    string
+	}{
+		"no provider extension": {
+			schemas: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+				"not_the_provider": base.CreateSchemaProxy(&base.Schema{
+					Description: "Not this one",
+				}),
+			}),
+		},
+		"valid provider extension": {
+			schemas: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+				"example_provider": base.CreateSchemaProxy(&base.Schema{
+					Description: "This is the provider schema",
+					Extensions:  extensions("x-terraform-provider", "example"),
+				}),
+				"not_the_provider": base.CreateSchemaProxy(&base.Schema{
+					Description: "Not this one",
+				}),
+			}),
+			expectedName:        "example",
+			expectedDescription: "This is the provider schema",
+		},
+		"multiple provider extensions": {
+			schemas: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+				"example_provider": base.CreateSchemaProxy(&base.Schema{
+					Extensions: extensions("x-terraform-provider", "example"),
+				}),
+				"other_provider": base.CreateSchemaProxy(&base.Schema{
+					Extensions: extensions("x-terraform-provider", "other"),
+				}),
+			}),
+			expectedErrRegex: `found multiple schemas with 'x-terraform-provider' extension`,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			extensionExplorer := explorer.NewExtensionExplorer(high.Document{Components: &high.Components{Schemas: testCase.schemas}})
+			got, err := extensionExplorer.FindProvider()
+
+			if testCase.expectedErrRegex != "" {
+				if err == nil {
+					t.Fatalf("expected error matching %q, got none", testCase.expectedErrRegex)
+				}
+				if !regexp.MustCompile(testCase.expectedErrRegex).MatchString(err.Error()) {
+					t.Fatalf("expected error matching %q, got: %s", testCase.expectedErrRegex, err)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("was not expecting error, got: %s", err)
+			}
+
+			if got.Name != testCase.expectedName {
+				t.Fatalf("expected provider name %s, got: %s", testCase.expectedName, got.Name)
+			}
+
+			if testCase.expectedName == "" {
+				if got.SchemaProxy != nil {
+					t.Fatal("expected schema proxy to be empty")
+				}
+				return
+			}
+
+			if got.SchemaProxy == nil {
+				t.Fatal("expected a schema proxy for provider, but didn't return one")
+			}
+
+			if got.SchemaProxy.Schema().Description != testCase.expectedDescription {
+				t.Fatalf("expected provider schema description %q, got: %q", testCase.expectedDescription, got.SchemaProxy.Schema().Description)
+			}
+		})
+	}
+}
+
+// extensions creates an extensions map from key/value pairs.
+func extensions(keyValues ...string) *orderedmap.Map[string, *yaml.Node] {
+	extensions := orderedmap.New[string, *yaml.Node]()
+	for i := 0; i+1 < len(keyValues); i += 2 {
+		extensions.Set(keyValues[i], &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: keyValues[i+1]})
+	}
+
+	return extensions
+}
@@ -9,6 +9,9 @@ import (
 	"path"
 	"regexp"
 	"strings"
+	"unicode"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
 
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
@@ -17,7 +20,8 @@ import (
 var _ Explorer = guesstimatorExplorer{}
 
 // guesstimatorExplorer is an experimental explorer that reads an OpenAPI specification without any configuration and attempts to
-// discover resources and data sources based on a naming convention. It's not currently in-use in the OpenAPI spec generator.
+// discover resources and data sources based on a naming convention. The same naming convention is used by GuessConfig to scaffold
+// a generator config with the `init` command.
 type guesstimatorExplorer struct {
 	spec high.Document
 }
@@ -27,13 +31,25 @@ type guesstimatorExplorer struct {
 var pathParameterRegex = regexp.MustCompile(`{.*}`)
 
 type resourceOperations struct {
+	// IdentityPath is the path that ends with a parameter: /path/{id}
+	IdentityPath string
 	// IdentityOps are operations (GET, PUT, POST, DELETE, etc.) on a path that ends with a parameter: /path/{id}
 	IdentityOps map[string]*high.Operation
 
+	// CollectionPath is the path that doesn't end with a parameter: /path
+	CollectionPath string
 	// CollectionOps are operations (GET, PUT, POST, DELETE, etc.) on a path that don't end with a parameter: /path
 	CollectionOps map[string]*high.Operation
 }
 
+// guessedResource contains the locations of the CRUD operations for a resource. Update is nil if no update operation is found.
+type guessedResource struct {
+	Create *config.OpenApiSpecLocation
+	Read   *config.OpenApiSpecLocation
+	Update *config.OpenApiSpecLocation
+	Delete *config.OpenApiSpecLocation
+}
+
 // As the name suggests, the Guesstimator evaluates an OpenAPIv3 spec and will return
 // Resources, DataSources, and their respective names, based on [RESTful conventions].
 //
@@ -73,27 +89,18 @@ func (e guesstimatorExplorer) FindProvider() (Provider, error) {
 func (e guesstimatorExplorer) FindResources() (map[string]Resource, error) {
 	resourcesMap := map[string]Resource{}
 
-	groupedResourceOperations := e.groupPathItems()
+	groupedResourceOperations := groupPathItems(e.spec.Paths)
 	for name, group := range groupedResourceOperations {
-		if group.CollectionOps == nil || group.IdentityOps == nil {
-			continue
-		}
-
-		if group.IdentityOps["get"] == nil || group.IdentityOps["delete"] == nil || group.CollectionOps["post"] == nil {
+		resource, ok := group.guessResource()
+		if !ok {
 			continue
 		}
 
-		// Fallback to POST on identity
-		createOp := group.CollectionOps["post"]
-		if createOp == nil {
-			createOp = group.IdentityOps["post"]
-		}
-
 		resourcesMap[name] = Resource{
-			CreateOp: createOp,
-			ReadOp:   group.IdentityOps["get"],
-			UpdateOp: group.IdentityOps["put"],
-			DeleteOp: group.IdentityOps["delete"],
+			CreateOp: group.operation(resource.Create),
+			ReadOp:   group.operation(resource.Read),
+			UpdateOp: group.operation(resource.Update),
+			DeleteOp: group.operation(resource.Delete),
 		}
 	}
 
@@ -106,47 +113,133 @@ func (e guesstimatorExplorer) FindResources() (map[string]Resource, error) {
 func (e guesstimatorExplorer) FindDataSources() (map[string]DataSource, error) {
 	dataSourcesMap := map[string]DataSource{}
 
-	groupedResourceOperations := e.groupPathItems()
+	groupedResourceOperations := groupPathItems(e.spec.Paths)
 	for name, group := range groupedResourceOperations {
-		if group.CollectionOps == nil || group.IdentityOps == nil {
-			continue
+		for dataSourceName, location := range group.guessDataSources(name) {
+			dataSourcesMap[dataSourceName] = DataSource{ReadOp: group.operation(location)}
 		}
+	}
 
-		if group.IdentityOps["get"] != nil {
-			// Combine all schemas into something that can be translated to framework IR
-			dataSourcesMap[name+"_by_id"] = DataSource{ReadOp: group.IdentityOps["get"]}
+	return dataSourcesMap, nil
+}
+
+// GuessConfig evaluates an OpenAPIv3 spec with the same naming convention as the Guesstimator explorer, and returns a generator
+// config with the locations of all discovered resources and data sources. The provider name is derived from the title of the
+// OpenAPI spec, and the provider schema_ref is set to a schema in `components/schemas` that's named like a provider configuration,
+// if one is found.
+func GuessConfig(spec high.Document) config.Config {
+	cfg := config.Config{
+		Provider: config.Provider{
+			Name:      guessProviderName(spec),
+			SchemaRef: guessProviderSchemaRef(spec),
+		},
+		Resources:   map[string]config.Resource{},
+		DataSources: map[string]config.DataSource{},
+	}
+
+	for name, group := range groupPathItems(spec.Paths) {
+		if resource, ok := group.guessResource(); ok {
+			cfg.Resources[name] = config.Resource{
+				Create: resource.Create,
+				Read:   resource.Read,
+				Update: resource.Update,
+				Delete: resource.Delete,
+			}
 		}
 
-		if group.CollectionOps["get"] != nil {
-			dataSourcesMap[name+"_collection"] = DataSource{ReadOp: group.CollectionOps["get"]}
+		for dataSourceName, location := range group.guessDataSources(name) {
+			cfg.DataSources[dataSourceName] = config.DataSource{Read: location}
 		}
 	}
 
-	return dataSourcesMap, nil
+	return cfg
+}
+
+// guessResource returns the CRUD operation locations of a resource. A valid resource has a POST collection operation, a GET
+// identity operation, and a DELETE identity operation. The update operation is a PUT identity operation, falling back to a
+// PATCH identity operation.
+func (g resourceOperations) guessResource() (guessedResource, bool) {
+	if g.IdentityOps["get"] == nil || g.IdentityOps["delete"] == nil || g.CollectionOps["post"] == nil {
+		return guessedResource{}, false
+	}
+
+	resource := guessedResource{
+		Create: &config.OpenApiSpecLocation{Path: g.CollectionPath, Method: "POST"},
+		Read:   &config.OpenApiSpecLocation{Path: g.IdentityPath, Method: "GET"},
+		Delete: &config.OpenApiSpecLocation{Path: g.IdentityPath, Method: "DELETE"},
+	}
+
+	if g.IdentityOps["put"] != nil {
+		resource.Update = &config.OpenApiSpecLocation{Path: g.IdentityPath, Method: "PUT"}
+	} else if g.IdentityOps["patch"] != nil {
+		resource.Update = &config.OpenApiSpecLocation{Path: g.IdentityPath, Method: "PATCH"}
+	}
+
+	return resource, true
+}
+
+// guessDataSources returns the read operation locations of all data sources, keyed by data source name. A GET identity operation
+// is a data source with a "_by_id" suffix, and a GET collection operation is a data source with a "_collection" suffix.
+func (g resourceOperations) guessDataSources(name string) map[string]*config.OpenApiSpecLocation {
+	dataSources := map[string]*config.OpenApiSpecLocation{}
+
+	if g.IdentityOps["get"] != nil {
+		dataSources[name+"_by_id"] = &config.OpenApiSpecLocation{Path: g.IdentityPath, Method: "GET"}
+	}
+
+	if g.CollectionOps["get"] != nil {
+		dataSources[name+"_collection"] = &config.OpenApiSpecLocation{Path: g.CollectionPath, Method: "GET"}
+	}
+
+	return dataSources
+}
+
+// operation returns the operation for a location guessed from this group, or nil if there is no location.
+func (g resourceOperations) operation(location *config.OpenApiSpecLocation) *high.Operation {
+	if location == nil {
+		return nil
+	}
+
+	method := strings.ToLower(location.Method)
+	if location.Path == g.IdentityPath && g.IdentityOps[method] != nil {
+		return g.IdentityOps[method]
+	}
+
+	return g.CollectionOps[method]
 }
 
 // groupPathItems groups all operations for potential TF resource/data source
 //   - Name of resource is determined by combining all nested path segments with underscores
-func (e guesstimatorExplorer) groupPathItems() map[string]resourceOperations {
-	groups := map[string]resourceOperations{}
+func groupPathItems(paths *high.Paths) map[string]*resourceOperations {
+	groups := map[string]*resourceOperations{}
+	if paths == nil {
+		return groups
+	}
 
-	for pair := range orderedmap.Iterate(context.TODO(), e.spec.Paths.PathItems) {
+	for pair := range orderedmap.Iterate(context.TODO(), paths.PathItems) {
 		resource, isIdentity := convertPathToResourceName(pair.Key())
 
-		_, ok := groups[resource]
+		group, ok := groups[resource]
 		if !ok {
-			groups[resource] = resourceOperations{
+			group = &resourceOperations{
 				IdentityOps:   map[string]*high.Operation{},
 				CollectionOps: map[string]*high.Operation{},
 			}
+			groups[resource] = group
+		}
+
+		if isIdentity {
+			group.IdentityPath = pair.Key()
+		} else {
+			group.CollectionPath = pair.Key()
 		}
 
 		ops := pair.Value().GetOperations()
 		for opPair := range orderedmap.Iterate(context.TODO(), ops) {
 			if isIdentity {
-				groups[resource].IdentityOps[opPair.Key()] = opPair.Value()
+				group.IdentityOps[opPair.Key()] = opPair.Value()
 			} else {
-				groups[resource].CollectionOps[opPair.Key()] = opPair.Value()
+				group.CollectionOps[opPair.Key()] = opPair.Value()
 			}
 		}
 	}
@@ -154,6 +247,45 @@ func (e guesstimatorExplorer) groupPathItems() map[string]resourceOperations {
 	return groups
 }
 
+// guessProviderName converts the title of the OpenAPI spec to a valid provider name, i.e. "Swagger Petstore" becomes swagger_petstore
+func guessProviderName(spec high.Document) string {
+	title := ""
+	if spec.Info != nil {
+		title = spec.Info.Title
+	}
+
+	nameParts := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if len(nameParts) == 0 {
+		return "provider"
+	}
+
+	return strings.Join(nameParts, "_")
+}
+
+// providerSchemaNames are lowercase schema names that commonly describe a provider configuration, in order of preference.
+var providerSchemaNames = []string{"provider", "providerconfig", "providerconfiguration", "config", "configuration", "credentials"}
+
+// guessProviderSchemaRef returns a reference to a schema in `components/schemas` that's named like a provider configuration, i.e.
+// Provider or ProviderConfig, or an empty string if none are found.
+func guessProviderSchemaRef(spec high.Document) string {
+	if spec.Components == nil || spec.Components.Schemas == nil {
+		return ""
+	}
+
+	for _, providerSchemaName := range providerSchemaNames {
+		for schemaName := range spec.Components.Schemas.KeysFromOldest() {
+			normalizedName := strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(schemaName))
+			if normalizedName == providerSchemaName {
+				return "#/components/schemas/" + schemaName
+			}
+		}
+	}
+
+	return ""
+}
+
 // convertPathToResourceName takes a given API path, /example/user/{username}, and converts it to a valid resource name by combining the paths with underscores, i.e. example_user
 func convertPathToResourceName(urlPath string) (string, bool) {
 	restOfPath, resource := path.Split(urlPath)
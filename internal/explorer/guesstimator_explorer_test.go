@@ -6,8 +6,11 @@ package explorer_test
 import (
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
 )
@@ -218,3 +221,130 @@ func Test_GuesstimatorExplorer_FindDataSources(t *testing.T) {
 		})
 	}
 }
+
+func Test_GuessConfig(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		spec           high.Document
+		expectedConfig config.Config
+	}{
+		"resources and data sources": {
+			spec: high.Document{
+				Info: &base.Info{
+					Title: "Very Cool API (v2)",
+				},
+				Paths: &high.Paths{
+					PathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+						"/verycool/{id}/resources": {
+							Get:  &high.Operation{},
+							Post: &high.Operation{},
+						},
+						"/verycool/{id}/resources/{resource_id}": {
+							Get:    &high.Operation{},
+							Patch:  &high.Operation{},
+							Delete: &high.Operation{},
+						},
+						"/things": {
+							Post: &high.Operation{},
+						},
+						"/things/{thing_id}": {
+							Get:    &high.Operation{},
+							Put:    &high.Operation{},
+							Patch:  &high.Operation{},
+							Delete: &high.Operation{},
+						},
+						"/widgets": {
+							Post: &high.Operation{},
+						},
+						"/widgets/{widget_id}": {
+							Get:    &high.Operation{},
+							Delete: &high.Operation{},
+						},
+					}),
+				},
+			},
+			expectedConfig: config.Config{
+				Provider: config.Provider{
+					Name: "very_cool_api_v2",
+				},
+				Resources: map[string]config.Resource{
+					"verycool_resources": {
+						Create: &config.OpenApiSpecLocation{Path: "/verycool/{id}/resources", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/verycool/{id}/resources/{resource_id}", Method: "GET"},
+						Update: &config.OpenApiSpecLocation{Path: "/verycool/{id}/resources/{resource_id}", Method: "PATCH"},
+						Delete: &config.OpenApiSpecLocation{Path: "/verycool/{id}/resources/{resource_id}", Method: "DELETE"},
+					},
+					"things": {
+						Create: &config.OpenApiSpecLocation{Path: "/things", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "GET"},
+						Update: &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "PUT"},
+						Delete: &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "DELETE"},
+					},
+					"widgets": {
+						Create: &config.OpenApiSpecLocation{Path: "/widgets", Method: "POST"},
+						Read:   &config.OpenApiSpecLocation{Path: "/widgets/{widget_id}", Method: "GET"},
+						Delete: &config.OpenApiSpecLocation{Path: "/widgets/{widget_id}", Method: "DELETE"},
+					},
+				},
+				DataSources: map[string]config.DataSource{
+					"verycool_resources_collection": {
+						Read: &config.OpenApiSpecLocation{Path: "/verycool/{id}/resources", Method: "GET"},
+					},
+					"verycool_resources_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/verycool/{id}/resources/{resource_id}", Method: "GET"},
+					},
+					"things_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "GET"},
+					},
+					"widgets_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/widgets/{widget_id}", Method: "GET"},
+					},
+				},
+			},
+		},
+		"provider schema_ref": {
+			spec: high.Document{
+				Paths: &high.Paths{
+					PathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+						"/things/{thing_id}": {
+							Get: &high.Operation{},
+						},
+					}),
+				},
+				Components: &high.Components{
+					Schemas: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+						"Thing":           base.CreateSchemaProxy(&base.Schema{}),
+						"Credentials":     base.CreateSchemaProxy(&base.Schema{}),
+						"Provider_Config": base.CreateSchemaProxy(&base.Schema{}),
+					}),
+				},
+			},
+			expectedConfig: config.Config{
+				Provider: config.Provider{
+					Name:      "provider",
+					SchemaRef: "#/components/schemas/Provider_Config",
+				},
+				Resources: map[string]config.Resource{},
+				DataSources: map[string]config.DataSource{
+					"things_by_id": {
+						Read: &config.OpenApiSpecLocation{Path: "/things/{thing_id}", Method: "GET"},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := explorer.GuessConfig(testCase.spec)
+
+			if diff := cmp.Diff(got, testCase.expectedConfig); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer
+
+var _ Explorer = mergedExplorer{}
+
+type mergedExplorer struct {
+	explorers []Explorer
+}
+
+// A MergedExplorer will combine the results of multiple explorers, in the order they are provided. Resources and data sources
+// found by a later explorer are added, or replace an entry with the same name found by an earlier explorer. For the provider,
+// any populated field from a later explorer replaces the field from an earlier explorer.
+//
+// For example, merging an ExtensionExplorer with a ConfigExplorer allows a generator config to add or override resources
+// and data sources that are identified with extensions in the OpenAPI spec.
+func NewMergedExplorer(explorers ...Explorer) Explorer {
+	return mergedExplorer{
+		explorers: explorers,
+	}
+}
+
+func (e mergedExplorer) FindProvider() (Provider, error) {
+	mergedProvider := Provider{}
+
+	for _, explorer := range e.explorers {
+		provider, err := explorer.FindProvider()
+		if err != nil {
+			return Provider{}, err
+		}
+
+		if provider.Name != "" {
+			mergedProvider.Name = provider.Name
+		}
+		if provider.SchemaProxy != nil {
+			mergedProvider.SchemaProxy = provider.SchemaProxy
+		}
+		if provider.Ignores != nil {
+			mergedProvider.Ignores = provider.Ignores
+		}
+	}
+
+	return mergedProvider, nil
+}
+
+func (e mergedExplorer) FindResources() (map[string]Resource, error) {
+	mergedResources := map[string]Resource{}
+
+	for _, explorer := range e.explorers {
+		resources, err := explorer.FindResources()
+		if err != nil {
+			return nil, err
+		}
+
+		for name, resource := range resources {
+			mergedResources[name] = resource
+		}
+	}
+
+	return mergedResources, nil
+}
+
+func (e mergedExplorer) FindDataSources() (map[string]DataSource, error) {
+	mergedDataSources := map[string]DataSource{}
+
+	for _, explorer := range e.explorers {
+		dataSources, err := explorer.FindDataSources()
+		if err != nil {
+			return nil, err
+		}
+
+		for name, dataSource := range dataSources {
+			mergedDataSources[name] = dataSource
+		}
+	}
+
+	return mergedDataSources, nil
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+func Test_MergedExplorer(t *testing.T) {
+	t.Parallel()
+
+	spec := high.Document{
+		Paths: &high.Paths{
+			PathItems: orderedmap.ToOrderedMap(map[string]*high.PathItem{
+				"/resources": {
+					Post: &high.Operation{
+						OperationId: "create_resource",
+						Extensions:  extensions("x-terraform-resource", "test_resource", "x-terraform-operation", "create"),
+					},
+				},
+				"/resources/{resource_id}": {
+					Get: &high.Operation{
+						OperationId: "read_resource",
+						Extensions: extensions(
+							"x-terraform-resource", "test_resource",
+							"x-terraform-operation", "read",
+							"x-terraform-data-source", "test_resource",
+						),
+					},
+				},
+				"/things/{thing_id}": {
+					Get: &high.Operation{
+						OperationId: "read_thing",
+					},
+				},
+			}),
+		},
+		Components: &high.Components{
+			Schemas: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+				"example_provider": base.CreateSchemaProxy(&base.Schema{
+					Description: "This is the provider schema",
+					Extensions:  extensions("x-terraform-provider", "example"),
+				}),
+			}),
+		},
+	}
+
+	cfg := config.Config{
+		Provider: config.Provider{
+			Name: "overridden",
+		},
+		DataSources: map[string]config.DataSource{
+			"test_resource": {
+				Read: &config.OpenApiSpecLocation{
+					Path:   "/things/{thing_id}",
+					Method: "GET",
+				},
+			},
+		},
+	}
+
+	mergedExplorer := explorer.NewMergedExplorer(explorer.NewExtensionExplorer(spec), explorer.NewConfigExplorer(spec, cfg))
+
+	provider, err := mergedExplorer.FindProvider()
+	if err != nil {
+		t.Fatalf("was not expecting error, got: %s", err)
+	}
+	if provider.Name != "overridden" {
+		t.Errorf("expected provider name to be overridden by config, got: %s", provider.Name)
+	}
+	if provider.SchemaProxy == nil {
+		t.Errorf("expected provider schema from extension, but didn't return one")
+	}
+
+	resources, err := mergedExplorer.FindResources()
+	if err != nil {
+		t.Fatalf("was not expecting error, got: %s", err)
+	}
+	if diff := cmp.Diff(slices.Sorted(maps.Keys(resources)), []string{"test_resource"}); diff != "" {
+		t.Errorf("unexpected difference in resources: %s", diff)
+	}
+
+	dataSources, err := mergedExplorer.FindDataSources()
+	if err != nil {
+		t.Fatalf("was not expecting error, got: %s", err)
+	}
+	if diff := cmp.Diff(slices.Sorted(maps.Keys(dataSources)), []string{"test_resource"}); diff != "" {
+		t.Errorf("unexpected difference in data sources: %s", diff)
+	}
+	if dataSources["test_resource"].ReadOp.OperationId != "read_thing" {
+		t.Errorf("expected data source to be overridden by config, got read op: %s", dataSources["test_resource"].ReadOp.OperationId)
+	}
+}
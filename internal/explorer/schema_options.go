@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer
+
+import "slices"
+
+// WithDefaults returns the schema options merged with the schema options from the `defaults` section of the generator config.
+// Ignores are combined, and aliases and overrides from the schema options take precedence over defaults for the same parameter
+// name or attribute location. Defaults that are listed in ExcludeDefaults are not merged.
+func (o SchemaOptions) WithDefaults(defaults SchemaOptions) SchemaOptions {
+	merged := SchemaOptions{
+		AttributeOptions: AttributeOptions{
+			Aliases:   mergeDefaults(defaults.AttributeOptions.Aliases, o.AttributeOptions.Aliases, o.ExcludeDefaults),
+			Overrides: mergeDefaults(defaults.AttributeOptions.Overrides, o.AttributeOptions.Overrides, o.ExcludeDefaults),
+		},
+		ExcludeDefaults: o.ExcludeDefaults,
+	}
+
+	for _, ignore := range defaults.Ignores {
+		if !slices.Contains(o.ExcludeDefaults, ignore) && !slices.Contains(o.Ignores, ignore) {
+			merged.Ignores = append(merged.Ignores, ignore)
+		}
+	}
+	merged.Ignores = append(merged.Ignores, o.Ignores...)
+
+	return merged
+}
+
+func mergeDefaults[V any](defaults map[string]V, options map[string]V, excludeDefaults []string) map[string]V {
+	if len(defaults) == 0 {
+		return options
+	}
+
+	merged := make(map[string]V, len(defaults)+len(options))
+	for key, value := range defaults {
+		if !slices.Contains(excludeDefaults, key) {
+			merged[key] = value
+		}
+	}
+
+	for key, value := range options {
+		merged[key] = value
+	}
+
+	return merged
+}
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestSchemaOptions_WithDefaults(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schemaOptions explorer.SchemaOptions
+		defaults      explorer.SchemaOptions
+		want          explorer.SchemaOptions
+	}{
+		"no defaults": {
+			schemaOptions: explorer.SchemaOptions{
+				Ignores: []string{"etag"},
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"petId": "id",
+					},
+				},
+			},
+			want: explorer.SchemaOptions{
+				Ignores: []string{"etag"},
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"petId": "id",
+					},
+				},
+			},
+		},
+		"merged defaults": {
+			schemaOptions: explorer.SchemaOptions{
+				Ignores: []string{"etag", "owner"},
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"petId": "pet_id",
+					},
+					Overrides: map[string]explorer.Override{
+						"name": {
+							Description: "resource description",
+						},
+					},
+				},
+			},
+			defaults: explorer.SchemaOptions{
+				Ignores: []string{"**._links", "etag"},
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"petId":   "id",
+						"storeId": "store",
+					},
+					Overrides: map[string]explorer.Override{
+						"name": {
+							Description: "default description",
+						},
+						"**.created_at": {
+							Description: "default description",
+						},
+					},
+				},
+			},
+			want: explorer.SchemaOptions{
+				Ignores: []string{"**._links", "etag", "owner"},
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"petId":   "pet_id",
+						"storeId": "store",
+					},
+					Overrides: map[string]explorer.Override{
+						"name": {
+							Description: "resource description",
+						},
+						"**.created_at": {
+							Description: "default description",
+						},
+					},
+				},
+			},
+		},
+		"excluded defaults": {
+			schemaOptions: explorer.SchemaOptions{
+				ExcludeDefaults: []string{"**._links", "storeId", "**.created_at"},
+			},
+			defaults: explorer.SchemaOptions{
+				Ignores: []string{"**._links", "etag"},
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"petId":   "id",
+						"storeId": "store",
+					},
+					Overrides: map[string]explorer.Override{
+						"**.created_at": {
+							Description: "default description",
+						},
+					},
+				},
+			},
+			want: explorer.SchemaOptions{
+				Ignores: []string{"etag"},
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"petId": "id",
+					},
+					Overrides: map[string]explorer.Override{},
+				},
+				ExcludeDefaults: []string{"**._links", "storeId", "**.created_at"},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schemaOptions.WithDefaults(testCase.defaults)
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceBoolAttribute struct {
@@ -32,7 +33,55 @@ func (a *ResourceBoolAttribute) Merge(mergeAttribute ResourceAttribute) (Resourc
 }
 
 func (a *ResourceBoolAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.BoolValidator{
+			Custom: validator,
+		})
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		a.PlanModifiers = append(a.PlanModifiers, schema.BoolPlanModifier{
+			Custom: planModifier,
+		})
+	}
+
+	if override.RemoveDefault {
+		a.Default = nil
+	}
+
+	if override.Default != nil {
+		a.Default = &schema.BoolDefault{
+			Custom: override.Default,
+		}
+	}
+
+	if err := validateDefaultComputability(a.Name, override, a.Default != nil); err != nil {
+		return a, err
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -65,7 +114,35 @@ func (a *DataSourceBoolAttribute) Merge(mergeAttribute DataSourceAttribute) (Dat
 }
 
 func (a *DataSourceBoolAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.BoolValidator{
+			Custom: validator,
+		})
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -83,6 +160,10 @@ type ProviderBoolAttribute struct {
 	Name string
 }
 
+func (a *ProviderBoolAttribute) GetName() string {
+	return a.Name
+}
+
 func (a *ProviderBoolAttribute) ToSpec() provider.Attribute {
 	return provider.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -154,6 +154,46 @@ func TestResourceBoolAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override without description": {
+			attribute: attrmapper.ResourceBoolAttribute{
+				Name: "test_attribute",
+				BoolAttribute: resource.BoolAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{},
+			expectedAttribute: &attrmapper.ResourceBoolAttribute{
+				Name: "test_attribute",
+				BoolAttribute: resource.BoolAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.ResourceBoolAttribute{
+				Name: "test_attribute",
+				BoolAttribute: resource.BoolAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.ResourceBoolAttribute{
+				Name: "test_attribute",
+				BoolAttribute: resource.BoolAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -308,6 +348,46 @@ func TestDataSourceBoolAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override without description": {
+			attribute: attrmapper.DataSourceBoolAttribute{
+				Name: "test_attribute",
+				BoolAttribute: datasource.BoolAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{},
+			expectedAttribute: &attrmapper.DataSourceBoolAttribute{
+				Name: "test_attribute",
+				BoolAttribute: datasource.BoolAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.DataSourceBoolAttribute{
+				Name: "test_attribute",
+				BoolAttribute: datasource.BoolAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.DataSourceBoolAttribute{
+				Name: "test_attribute",
+				BoolAttribute: datasource.BoolAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 )
 
@@ -20,6 +21,7 @@ type DataSourceAttribute interface {
 
 type DataSourceNestedAttribute interface {
 	ApplyNestedOverride([]string, explorer.Override) (DataSourceAttribute, error)
+	NestedAttributes() DataSourceAttributes
 }
 
 type DataSourceAttributes []DataSourceAttribute
@@ -67,11 +69,64 @@ func (attributes DataSourceAttributes) ToSpec() []datasource.Attribute {
 	return specAttributes
 }
 
+// Paths returns the dot-separated location of every attribute, including nested attributes, in the same format as
+// overrides in the generator config.
+func (attributes DataSourceAttributes) Paths() []string {
+	paths := []string{}
+	for _, attribute := range attributes {
+		paths = append(paths, attribute.GetName())
+
+		if nestedAttribute, ok := attribute.(DataSourceNestedAttribute); ok {
+			for _, nestedPath := range nestedAttribute.NestedAttributes().Paths() {
+				paths = append(paths, attribute.GetName()+"."+nestedPath)
+			}
+		}
+	}
+
+	return paths
+}
+
+// IgnorePaths returns the dot-separated location of every attribute that can be ignored in the generator config. In addition to
+// attributes and nested attributes, this includes the attributes of object element types in collections, which can be ignored
+// but not overridden.
+func (attributes DataSourceAttributes) IgnorePaths() []string {
+	paths := []string{}
+	for _, attribute := range attributes {
+		paths = append(paths, attribute.GetName())
+
+		var nestedPaths []string
+		switch a := attribute.(type) {
+		case *DataSourceListAttribute:
+			nestedPaths = elementTypePaths(a.ElementType)
+		case *DataSourceMapAttribute:
+			nestedPaths = elementTypePaths(a.ElementType)
+		case *DataSourceSetAttribute:
+			nestedPaths = elementTypePaths(a.ElementType)
+		case DataSourceNestedAttribute:
+			nestedPaths = a.NestedAttributes().IgnorePaths()
+		}
+
+		for _, nestedPath := range nestedPaths {
+			paths = append(paths, attribute.GetName()+"."+nestedPath)
+		}
+	}
+
+	return paths
+}
+
+// ApplyOverrides applies the overrides to every attribute with a location that matches the override locations. The overrides
+// that match an attribute are merged, in the order of util.SortedLocationKeys, so an attribute that matches multiple location
+// patterns is only overridden once.
 func (attributes DataSourceAttributes) ApplyOverrides(overrideMap map[string]explorer.Override) (DataSourceAttributes, error) {
 	var errResult error
-	for key, override := range overrideMap {
+	for _, location := range attributes.Paths() {
+		override, ok := mergeOverrides(location, overrideMap)
+		if !ok {
+			continue
+		}
+
 		var err error
-		attributes, err = attributes.ApplyOverride(strings.Split(key, "."), override)
+		attributes, err = attributes.ApplyOverride(strings.Split(location, util.LocationSeparator), override)
 		errResult = errors.Join(errResult, err)
 	}
 
@@ -354,6 +354,109 @@ func TestDataSourceAttributes_ApplyOverrides(t *testing.T) {
 				},
 			},
 		},
+		"matching nested type override": {
+			overrides: map[string]explorer.Override{
+				"nested_attribute.list_attribute": {
+					ComputedOptionalRequired: schema.ComputedOptional,
+					Type:                     "set",
+				},
+			},
+			attributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceSingleNestedAttribute{
+					Name: "nested_attribute",
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceListAttribute{
+							Name: "list_attribute",
+							ListAttribute: datasource.ListAttribute{
+								ComputedOptionalRequired: schema.Required,
+								ElementType: schema.ElementType{
+									String: &schema.StringType{},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: datasource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceSingleNestedAttribute{
+					Name: "nested_attribute",
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceSetAttribute{
+							Name: "list_attribute",
+							SetAttribute: datasource.SetAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								ElementType: schema.ElementType{
+									String: &schema.StringType{},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: datasource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+		"overlapping override patterns": {
+			overrides: map[string]explorer.Override{
+				"*.etag": {
+					Validators: []*schema.CustomValidator{
+						{
+							SchemaDefinition: "validators.ETag()",
+						},
+					},
+				},
+				"**.etag": {
+					Validators: []*schema.CustomValidator{
+						{
+							SchemaDefinition: "validators.ETag()",
+						},
+					},
+				},
+			},
+			attributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceStringAttribute{
+							Name: "etag",
+							StringAttribute: datasource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+							},
+						},
+					},
+					SingleNestedAttribute: datasource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceStringAttribute{
+							Name: "etag",
+							StringAttribute: datasource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+								Validators: schema.StringValidators{
+									{
+										Custom: &schema.CustomValidator{
+											SchemaDefinition: "validators.ETag()",
+										},
+									},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: datasource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
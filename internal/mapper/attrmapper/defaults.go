@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package attrmapper
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// resourceAttributeWithDefaultValue is a collection or object attribute with a default decoded from the OpenAPI spec, which is
+// mapped to a static default by ResolveDefaults.
+type resourceAttributeWithDefaultValue interface {
+	resolveDefault() error
+}
+
+// invalidDefaultError returns the error for a default decoded from the OpenAPI spec that doesn't match the type of the attribute.
+func invalidDefaultError(name string, err error) error {
+	return fmt.Errorf("default of attribute '%s' doesn't match the type of the attribute: %w", name, err)
+}
+
+// objectAttributeTypes returns the object attribute types of nested attributes, which are used to create default values of
+// nested attributes.
+func objectAttributeTypes(attributes []resource.Attribute) []schema.ObjectAttributeType {
+	attributeTypes := make([]schema.ObjectAttributeType, 0, len(attributes))
+
+	for _, attribute := range attributes {
+		attributeType := schema.ObjectAttributeType{Name: attribute.Name}
+
+		switch {
+		case attribute.Bool != nil:
+			attributeType.Bool = &schema.BoolType{CustomType: attribute.Bool.CustomType}
+		case attribute.Float64 != nil:
+			attributeType.Float64 = &schema.Float64Type{CustomType: attribute.Float64.CustomType}
+		case attribute.Int64 != nil:
+			attributeType.Int64 = &schema.Int64Type{CustomType: attribute.Int64.CustomType}
+		case attribute.Number != nil:
+			attributeType.Number = &schema.NumberType{CustomType: attribute.Number.CustomType}
+		case attribute.String != nil:
+			attributeType.String = &schema.StringType{CustomType: attribute.String.CustomType}
+		case attribute.List != nil:
+			attributeType.List = &schema.ListType{CustomType: attribute.List.CustomType, ElementType: attribute.List.ElementType}
+		case attribute.Map != nil:
+			attributeType.Map = &schema.MapType{CustomType: attribute.Map.CustomType, ElementType: attribute.Map.ElementType}
+		case attribute.Set != nil:
+			attributeType.Set = &schema.SetType{CustomType: attribute.Set.CustomType, ElementType: attribute.Set.ElementType}
+		case attribute.ListNested != nil:
+			attributeType.List = &schema.ListType{
+				CustomType:  attribute.ListNested.CustomType,
+				ElementType: nestedObjectElementType(attribute.ListNested.NestedObject),
+			}
+		case attribute.MapNested != nil:
+			attributeType.Map = &schema.MapType{
+				CustomType:  attribute.MapNested.CustomType,
+				ElementType: nestedObjectElementType(attribute.MapNested.NestedObject),
+			}
+		case attribute.SetNested != nil:
+			attributeType.Set = &schema.SetType{
+				CustomType:  attribute.SetNested.CustomType,
+				ElementType: nestedObjectElementType(attribute.SetNested.NestedObject),
+			}
+		case attribute.SingleNested != nil:
+			attributeType.Object = &schema.ObjectType{
+				AttributeTypes: objectAttributeTypes(attribute.SingleNested.Attributes),
+				CustomType:     attribute.SingleNested.CustomType,
+			}
+		}
+
+		attributeTypes = append(attributeTypes, attributeType)
+	}
+
+	return attributeTypes
+}
+
+func nestedObjectElementType(nestedObject resource.NestedAttributeObject) schema.ElementType {
+	return schema.ElementType{
+		Object: &schema.ObjectType{
+			AttributeTypes: objectAttributeTypes(nestedObject.Attributes),
+			CustomType:     nestedObject.CustomType,
+		},
+	}
+}
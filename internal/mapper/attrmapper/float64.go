@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceFloat64Attribute struct {
@@ -32,7 +33,55 @@ func (a *ResourceFloat64Attribute) Merge(mergeAttribute ResourceAttribute) (Reso
 }
 
 func (a *ResourceFloat64Attribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.Float64Validator{
+			Custom: validator,
+		})
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		a.PlanModifiers = append(a.PlanModifiers, schema.Float64PlanModifier{
+			Custom: planModifier,
+		})
+	}
+
+	if override.RemoveDefault {
+		a.Default = nil
+	}
+
+	if override.Default != nil {
+		a.Default = &schema.Float64Default{
+			Custom: override.Default,
+		}
+	}
+
+	if err := validateDefaultComputability(a.Name, override, a.Default != nil); err != nil {
+		return a, err
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -65,7 +114,35 @@ func (a *DataSourceFloat64Attribute) Merge(mergeAttribute DataSourceAttribute) (
 }
 
 func (a *DataSourceFloat64Attribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.Float64Validator{
+			Custom: validator,
+		})
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -83,6 +160,10 @@ type ProviderFloat64Attribute struct {
 	Name string
 }
 
+func (a *ProviderFloat64Attribute) GetName() string {
+	return a.Name
+}
+
 func (a *ProviderFloat64Attribute) ToSpec() provider.Attribute {
 	return provider.Attribute{
 		Name:    util.TerraformIdentifier(a.Name),
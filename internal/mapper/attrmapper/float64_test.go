@@ -154,6 +154,29 @@ func TestResourceFloat64Attribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.ResourceFloat64Attribute{
+				Name: "test_attribute",
+				Float64Attribute: resource.Float64Attribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.ResourceFloat64Attribute{
+				Name: "test_attribute",
+				Float64Attribute: resource.Float64Attribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -308,6 +331,29 @@ func TestDataSourceFloat64Attribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.DataSourceFloat64Attribute{
+				Name: "test_attribute",
+				Float64Attribute: datasource.Float64Attribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.DataSourceFloat64Attribute{
+				Name: "test_attribute",
+				Float64Attribute: datasource.Float64Attribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
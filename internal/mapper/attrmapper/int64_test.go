@@ -154,6 +154,29 @@ func TestResourceInt64Attribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.ResourceInt64Attribute{
+				Name: "test_attribute",
+				Int64Attribute: resource.Int64Attribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.ResourceInt64Attribute{
+				Name: "test_attribute",
+				Int64Attribute: resource.Int64Attribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -308,6 +331,29 @@ func TestDataSourceInt64Attribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.DataSourceInt64Attribute{
+				Name: "test_attribute",
+				Int64Attribute: datasource.Int64Attribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.DataSourceInt64Attribute{
+				Name: "test_attribute",
+				Int64Attribute: datasource.Int64Attribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -5,16 +5,21 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceListAttribute struct {
 	resource.ListAttribute
 
 	Name string
+
+	// DefaultValue is the default decoded from the OpenAPI spec, see ResolveDefaults.
+	DefaultValue any
 }
 
 func (a *ResourceListAttribute) GetName() string {
@@ -37,11 +42,95 @@ func (a *ResourceListAttribute) Merge(mergeAttribute ResourceAttribute) (Resourc
 }
 
 func (a *ResourceListAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	// Convert the attribute first, so the remaining overrides are applied to the converted attribute
+	if override.Type == typeOverrideSet {
+		// Validators removed by the override don't need to be converted
+		if override.RemoveValidators {
+			a.Validators = nil
+		}
+
+		setAttribute, err := a.toSetAttribute()
+		if err != nil {
+			return a, err
+		}
+
+		return setAttribute.ApplyOverride(override)
+	}
+
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.ListValidator{
+			Custom: validator,
+		})
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		a.PlanModifiers = append(a.PlanModifiers, schema.ListPlanModifier{
+			Custom: planModifier,
+		})
+	}
+
+	if override.RemoveDefault {
+		a.Default = nil
+		a.DefaultValue = nil
+	}
+
+	if override.Default != nil {
+		a.Default = &schema.ListDefault{
+			Custom: override.Default,
+		}
+		a.DefaultValue = nil
+	}
+
+	if err := validateDefaultComputability(a.Name, override, a.Default != nil || a.DefaultValue != nil); err != nil {
+		return a, err
+	}
 
 	return a, nil
 }
 
+// toSetAttribute converts the list attribute to a set attribute for a type override. Validators are converted to the setvalidator
+// package, and custom types and plan modifiers are dropped, as they're specific to lists.
+func (a *ResourceListAttribute) toSetAttribute() (*ResourceSetAttribute, error) {
+	validators, err := setValidators(a.Name, a.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceSetAttribute{
+		Name: a.Name,
+		SetAttribute: resource.SetAttribute{
+			AssociatedExternalType:   a.AssociatedExternalType,
+			ComputedOptionalRequired: a.ComputedOptionalRequired,
+			DeprecationMessage:       a.DeprecationMessage,
+			Description:              a.Description,
+			ElementType:              a.ElementType,
+			Sensitive:                a.Sensitive,
+			Validators:               validators,
+		},
+		DefaultValue: a.DefaultValue,
+	}, nil
+}
+
 func (a *ResourceListAttribute) ToSpec() resource.Attribute {
 	return resource.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -49,6 +138,27 @@ func (a *ResourceListAttribute) ToSpec() resource.Attribute {
 	}
 }
 
+func (a *ResourceListAttribute) resolveDefault() error {
+	if a.DefaultValue == nil {
+		return nil
+	}
+
+	customDefault, err := frameworkdefaults.ListDefaultStaticValue(a.ElementType, a.DefaultValue)
+	if err != nil {
+		return invalidDefaultError(a.Name, err)
+	}
+
+	if a.ComputedOptionalRequired == schema.Required {
+		a.ComputedOptionalRequired = schema.ComputedOptional
+	}
+
+	a.Default = &schema.ListDefault{
+		Custom: customDefault,
+	}
+
+	return nil
+}
+
 type DataSourceListAttribute struct {
 	datasource.ListAttribute
 
@@ -75,11 +185,72 @@ func (a *DataSourceListAttribute) Merge(mergeAttribute DataSourceAttribute) (Dat
 }
 
 func (a *DataSourceListAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	// Convert the attribute first, so the remaining overrides are applied to the converted attribute
+	if override.Type == typeOverrideSet {
+		// Validators removed by the override don't need to be converted
+		if override.RemoveValidators {
+			a.Validators = nil
+		}
+
+		setAttribute, err := a.toSetAttribute()
+		if err != nil {
+			return a, err
+		}
+
+		return setAttribute.ApplyOverride(override)
+	}
+
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.ListValidator{
+			Custom: validator,
+		})
+	}
 
 	return a, nil
 }
 
+// toSetAttribute converts the list attribute to a set attribute for a type override. Validators are converted to the setvalidator
+// package, and custom types are dropped, as they're specific to lists.
+func (a *DataSourceListAttribute) toSetAttribute() (*DataSourceSetAttribute, error) {
+	validators, err := setValidators(a.Name, a.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataSourceSetAttribute{
+		Name: a.Name,
+		SetAttribute: datasource.SetAttribute{
+			AssociatedExternalType:   a.AssociatedExternalType,
+			ComputedOptionalRequired: a.ComputedOptionalRequired,
+			DeprecationMessage:       a.DeprecationMessage,
+			Description:              a.Description,
+			ElementType:              a.ElementType,
+			Sensitive:                a.Sensitive,
+			Validators:               validators,
+		},
+	}, nil
+}
+
 func (a *DataSourceListAttribute) ToSpec() datasource.Attribute {
 	return datasource.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -93,6 +264,10 @@ type ProviderListAttribute struct {
 	Name string
 }
 
+func (a *ProviderListAttribute) GetName() string {
+	return a.Name
+}
+
 func (a *ProviderListAttribute) ToSpec() provider.Attribute {
 	return provider.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceListNestedAttribute struct {
@@ -16,6 +18,9 @@ type ResourceListNestedAttribute struct {
 
 	Name         string
 	NestedObject ResourceNestedAttributeObject
+
+	// DefaultValue is the default decoded from the OpenAPI spec, see ResolveDefaults.
+	DefaultValue any
 }
 
 func (a *ResourceListNestedAttribute) GetName() string {
@@ -38,11 +43,94 @@ func (a *ResourceListNestedAttribute) Merge(mergeAttribute ResourceAttribute) (R
 }
 
 func (a *ResourceListNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	// Convert the attribute first, so the remaining overrides are applied to the converted attribute
+	if override.Type == typeOverrideSet {
+		// Validators removed by the override don't need to be converted
+		if override.RemoveValidators {
+			a.Validators = nil
+		}
+
+		setNestedAttribute, err := a.toSetNestedAttribute()
+		if err != nil {
+			return a, err
+		}
+
+		return setNestedAttribute.ApplyOverride(override)
+	}
+
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.ListValidator{
+			Custom: validator,
+		})
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		a.PlanModifiers = append(a.PlanModifiers, schema.ListPlanModifier{
+			Custom: planModifier,
+		})
+	}
+
+	if override.RemoveDefault {
+		a.Default = nil
+		a.DefaultValue = nil
+	}
+
+	if override.Default != nil {
+		a.Default = &schema.ListDefault{
+			Custom: override.Default,
+		}
+		a.DefaultValue = nil
+	}
+
+	if err := validateDefaultComputability(a.Name, override, a.Default != nil || a.DefaultValue != nil); err != nil {
+		return a, err
+	}
 
 	return a, nil
 }
 
+// toSetNestedAttribute converts the list nested attribute to a set nested attribute for a type override. Validators are
+// converted to the setvalidator package, and custom types and plan modifiers are dropped, as they're specific to lists.
+func (a *ResourceListNestedAttribute) toSetNestedAttribute() (*ResourceSetNestedAttribute, error) {
+	validators, err := setValidators(a.Name, a.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceSetNestedAttribute{
+		Name:         a.Name,
+		NestedObject: a.NestedObject,
+		SetNestedAttribute: resource.SetNestedAttribute{
+			ComputedOptionalRequired: a.ComputedOptionalRequired,
+			DeprecationMessage:       a.DeprecationMessage,
+			Description:              a.Description,
+			Sensitive:                a.Sensitive,
+			Validators:               validators,
+		},
+		DefaultValue: a.DefaultValue,
+	}, nil
+}
+
 func (a *ResourceListNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (ResourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
@@ -50,6 +138,10 @@ func (a *ResourceListNestedAttribute) ApplyNestedOverride(path []string, overrid
 	return a, err
 }
 
+func (a *ResourceListNestedAttribute) NestedAttributes() ResourceAttributes {
+	return a.NestedObject.Attributes
+}
+
 func (a *ResourceListNestedAttribute) ToSpec() resource.Attribute {
 	a.ListNestedAttribute.NestedObject = resource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -61,6 +153,27 @@ func (a *ResourceListNestedAttribute) ToSpec() resource.Attribute {
 	}
 }
 
+func (a *ResourceListNestedAttribute) resolveDefault() error {
+	if a.DefaultValue == nil {
+		return nil
+	}
+
+	customDefault, err := frameworkdefaults.ListDefaultStaticValue(nestedObjectElementType(a.ToSpec().ListNested.NestedObject), a.DefaultValue)
+	if err != nil {
+		return invalidDefaultError(a.Name, err)
+	}
+
+	if a.ComputedOptionalRequired == schema.Required {
+		a.ComputedOptionalRequired = schema.ComputedOptional
+	}
+
+	a.Default = &schema.ListDefault{
+		Custom: customDefault,
+	}
+
+	return nil
+}
+
 type DataSourceListNestedAttribute struct {
 	datasource.ListNestedAttribute
 
@@ -88,11 +201,71 @@ func (a *DataSourceListNestedAttribute) Merge(mergeAttribute DataSourceAttribute
 }
 
 func (a *DataSourceListNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	// Convert the attribute first, so the remaining overrides are applied to the converted attribute
+	if override.Type == typeOverrideSet {
+		// Validators removed by the override don't need to be converted
+		if override.RemoveValidators {
+			a.Validators = nil
+		}
+
+		setNestedAttribute, err := a.toSetNestedAttribute()
+		if err != nil {
+			return a, err
+		}
+
+		return setNestedAttribute.ApplyOverride(override)
+	}
+
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.ListValidator{
+			Custom: validator,
+		})
+	}
 
 	return a, nil
 }
 
+// toSetNestedAttribute converts the list nested attribute to a set nested attribute for a type override. Validators are
+// converted to the setvalidator package, and custom types are dropped, as they're specific to lists.
+func (a *DataSourceListNestedAttribute) toSetNestedAttribute() (*DataSourceSetNestedAttribute, error) {
+	validators, err := setValidators(a.Name, a.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataSourceSetNestedAttribute{
+		Name:         a.Name,
+		NestedObject: a.NestedObject,
+		SetNestedAttribute: datasource.SetNestedAttribute{
+			ComputedOptionalRequired: a.ComputedOptionalRequired,
+			DeprecationMessage:       a.DeprecationMessage,
+			Description:              a.Description,
+			Sensitive:                a.Sensitive,
+			Validators:               validators,
+		},
+	}, nil
+}
+
 func (a *DataSourceListNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (DataSourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
@@ -100,6 +273,10 @@ func (a *DataSourceListNestedAttribute) ApplyNestedOverride(path []string, overr
 	return a, err
 }
 
+func (a *DataSourceListNestedAttribute) NestedAttributes() DataSourceAttributes {
+	return a.NestedObject.Attributes
+}
+
 func (a *DataSourceListNestedAttribute) ToSpec() datasource.Attribute {
 	a.ListNestedAttribute.NestedObject = datasource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -118,6 +295,14 @@ type ProviderListNestedAttribute struct {
 	NestedObject ProviderNestedAttributeObject
 }
 
+func (a *ProviderListNestedAttribute) GetName() string {
+	return a.Name
+}
+
+func (a *ProviderListNestedAttribute) NestedAttributes() ProviderAttributes {
+	return a.NestedObject.Attributes
+}
+
 func (a *ProviderListNestedAttribute) ToSpec() provider.Attribute {
 	a.ListNestedAttribute.NestedObject = provider.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
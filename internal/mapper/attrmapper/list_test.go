@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 )
 
 func TestResourceListAttribute_Merge(t *testing.T) {
@@ -514,6 +515,182 @@ func TestResourceListAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override type to set": {
+			attribute: attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.ListValidators{
+						{
+							Custom: frameworkvalidators.ListValidatorSizeAtLeast(1),
+						},
+						{
+							Custom: frameworkvalidators.ListValidatorUniqueValues(),
+						},
+					},
+				},
+				DefaultValue: []any{"one"},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Type:                     "set",
+			},
+			expectedAttribute: &attrmapper.ResourceSetAttribute{
+				Name: "test_attribute",
+				SetAttribute: resource.SetAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					Description:              pointer("old description"),
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.SetValidators{
+						{
+							Custom: frameworkvalidators.SetValidatorSizeAtLeast(1),
+						},
+					},
+				},
+				DefaultValue: []any{"one"},
+			},
+		},
+		"override type to set with element validators": {
+			attribute: attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.ListValidators{
+						{
+							Custom: frameworkvalidators.ListValidatorValueStringsAre([]*schema.CustomValidator{
+								frameworkvalidators.StringValidatorLengthAtLeast(1),
+							}),
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				Type: "set",
+			},
+			expectedAttribute: &attrmapper.ResourceSetAttribute{
+				Name: "test_attribute",
+				SetAttribute: resource.SetAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.SetValidators{
+						{
+							Custom: frameworkvalidators.SetValidatorValueStringsAre([]*schema.CustomValidator{
+								frameworkvalidators.StringValidatorLengthAtLeast(1),
+							}),
+						},
+					},
+				},
+			},
+		},
+		"override type to set and replace custom validators": {
+			attribute: attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.ListValidators{
+						{
+							Custom: &schema.CustomValidator{
+								SchemaDefinition: "validators.SortedList()",
+							},
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				Type:             "set",
+				RemoveValidators: true,
+				Validators: []*schema.CustomValidator{
+					{
+						SchemaDefinition: "validators.SortedSet()",
+					},
+				},
+			},
+			expectedAttribute: &attrmapper.ResourceSetAttribute{
+				Name: "test_attribute",
+				SetAttribute: resource.SetAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.SetValidators{
+						{
+							Custom: &schema.CustomValidator{
+								SchemaDefinition: "validators.SortedSet()",
+							},
+						},
+					},
+				},
+			},
+		},
+		"override default": {
+			attribute: attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+				DefaultValue: []any{"one"},
+			},
+			override: explorer.Override{
+				Default: &schema.CustomDefault{
+					SchemaDefinition: "listdefault.StaticValue(types.ListNull(types.StringType))",
+				},
+			},
+			expectedAttribute: &attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					Default: &schema.ListDefault{
+						Custom: &schema.CustomDefault{
+							SchemaDefinition: "listdefault.StaticValue(types.ListNull(types.StringType))",
+						},
+					},
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+			},
+		},
+		"remove default": {
+			attribute: attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+				DefaultValue: []any{"one"},
+			},
+			override: explorer.Override{
+				RemoveDefault: true,
+			},
+			expectedAttribute: &attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -1028,6 +1205,39 @@ func TestDataSourceListAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override type to set": {
+			attribute: attrmapper.DataSourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: datasource.ListAttribute{
+					ComputedOptionalRequired: schema.Computed,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.ListValidators{
+						{
+							Custom: frameworkvalidators.ListValidatorSizeAtLeast(1),
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				Type: "set",
+			},
+			expectedAttribute: &attrmapper.DataSourceSetAttribute{
+				Name: "test_attribute",
+				SetAttribute: datasource.SetAttribute{
+					ComputedOptionalRequired: schema.Computed,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.SetValidators{
+						{
+							Custom: frameworkvalidators.SetValidatorSizeAtLeast(1),
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
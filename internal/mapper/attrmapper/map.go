@@ -5,16 +5,21 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceMapAttribute struct {
 	resource.MapAttribute
 
 	Name string
+
+	// DefaultValue is the default decoded from the OpenAPI spec, see ResolveDefaults.
+	DefaultValue any
 }
 
 func (a *ResourceMapAttribute) GetName() string {
@@ -37,7 +42,57 @@ func (a *ResourceMapAttribute) Merge(mergeAttribute ResourceAttribute) (Resource
 }
 
 func (a *ResourceMapAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.MapValidator{
+			Custom: validator,
+		})
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		a.PlanModifiers = append(a.PlanModifiers, schema.MapPlanModifier{
+			Custom: planModifier,
+		})
+	}
+
+	if override.RemoveDefault {
+		a.Default = nil
+		a.DefaultValue = nil
+	}
+
+	if override.Default != nil {
+		a.Default = &schema.MapDefault{
+			Custom: override.Default,
+		}
+		a.DefaultValue = nil
+	}
+
+	if err := validateDefaultComputability(a.Name, override, a.Default != nil || a.DefaultValue != nil); err != nil {
+		return a, err
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -49,6 +104,27 @@ func (a *ResourceMapAttribute) ToSpec() resource.Attribute {
 	}
 }
 
+func (a *ResourceMapAttribute) resolveDefault() error {
+	if a.DefaultValue == nil {
+		return nil
+	}
+
+	customDefault, err := frameworkdefaults.MapDefaultStaticValue(a.ElementType, a.DefaultValue)
+	if err != nil {
+		return invalidDefaultError(a.Name, err)
+	}
+
+	if a.ComputedOptionalRequired == schema.Required {
+		a.ComputedOptionalRequired = schema.ComputedOptional
+	}
+
+	a.Default = &schema.MapDefault{
+		Custom: customDefault,
+	}
+
+	return nil
+}
+
 type DataSourceMapAttribute struct {
 	datasource.MapAttribute
 
@@ -75,7 +151,35 @@ func (a *DataSourceMapAttribute) Merge(mergeAttribute DataSourceAttribute) (Data
 }
 
 func (a *DataSourceMapAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.MapValidator{
+			Custom: validator,
+		})
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -93,6 +197,10 @@ type ProviderMapAttribute struct {
 	Name string
 }
 
+func (a *ProviderMapAttribute) GetName() string {
+	return a.Name
+}
+
 func (a *ProviderMapAttribute) ToSpec() provider.Attribute {
 	return provider.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
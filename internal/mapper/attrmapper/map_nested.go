@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceMapNestedAttribute struct {
@@ -16,6 +18,9 @@ type ResourceMapNestedAttribute struct {
 
 	Name         string
 	NestedObject ResourceNestedAttributeObject
+
+	// DefaultValue is the default decoded from the OpenAPI spec, see ResolveDefaults.
+	DefaultValue any
 }
 
 func (a *ResourceMapNestedAttribute) GetName() string {
@@ -38,7 +43,57 @@ func (a *ResourceMapNestedAttribute) Merge(mergeAttribute ResourceAttribute) (Re
 }
 
 func (a *ResourceMapNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.MapValidator{
+			Custom: validator,
+		})
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		a.PlanModifiers = append(a.PlanModifiers, schema.MapPlanModifier{
+			Custom: planModifier,
+		})
+	}
+
+	if override.RemoveDefault {
+		a.Default = nil
+		a.DefaultValue = nil
+	}
+
+	if override.Default != nil {
+		a.Default = &schema.MapDefault{
+			Custom: override.Default,
+		}
+		a.DefaultValue = nil
+	}
+
+	if err := validateDefaultComputability(a.Name, override, a.Default != nil || a.DefaultValue != nil); err != nil {
+		return a, err
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -50,6 +105,10 @@ func (a *ResourceMapNestedAttribute) ApplyNestedOverride(path []string, override
 	return a, err
 }
 
+func (a *ResourceMapNestedAttribute) NestedAttributes() ResourceAttributes {
+	return a.NestedObject.Attributes
+}
+
 func (a *ResourceMapNestedAttribute) ToSpec() resource.Attribute {
 	a.MapNestedAttribute.NestedObject = resource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -61,6 +120,27 @@ func (a *ResourceMapNestedAttribute) ToSpec() resource.Attribute {
 	}
 }
 
+func (a *ResourceMapNestedAttribute) resolveDefault() error {
+	if a.DefaultValue == nil {
+		return nil
+	}
+
+	customDefault, err := frameworkdefaults.MapDefaultStaticValue(nestedObjectElementType(a.ToSpec().MapNested.NestedObject), a.DefaultValue)
+	if err != nil {
+		return invalidDefaultError(a.Name, err)
+	}
+
+	if a.ComputedOptionalRequired == schema.Required {
+		a.ComputedOptionalRequired = schema.ComputedOptional
+	}
+
+	a.Default = &schema.MapDefault{
+		Custom: customDefault,
+	}
+
+	return nil
+}
+
 type DataSourceMapNestedAttribute struct {
 	datasource.MapNestedAttribute
 
@@ -88,7 +168,35 @@ func (a *DataSourceMapNestedAttribute) Merge(mergeAttribute DataSourceAttribute)
 }
 
 func (a *DataSourceMapNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.MapValidator{
+			Custom: validator,
+		})
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -100,6 +208,10 @@ func (a *DataSourceMapNestedAttribute) ApplyNestedOverride(path []string, overri
 	return a, err
 }
 
+func (a *DataSourceMapNestedAttribute) NestedAttributes() DataSourceAttributes {
+	return a.NestedObject.Attributes
+}
+
 func (a *DataSourceMapNestedAttribute) ToSpec() datasource.Attribute {
 	a.MapNestedAttribute.NestedObject = datasource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -118,6 +230,14 @@ type ProviderMapNestedAttribute struct {
 	NestedObject ProviderNestedAttributeObject
 }
 
+func (a *ProviderMapNestedAttribute) GetName() string {
+	return a.Name
+}
+
+func (a *ProviderMapNestedAttribute) NestedAttributes() ProviderAttributes {
+	return a.NestedObject.Attributes
+}
+
 func (a *ProviderMapNestedAttribute) ToSpec() provider.Attribute {
 	a.MapNestedAttribute.NestedObject = provider.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -154,6 +154,29 @@ func TestResourceNumberAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.ResourceNumberAttribute{
+				Name: "test_attribute",
+				NumberAttribute: resource.NumberAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.ResourceNumberAttribute{
+				Name: "test_attribute",
+				NumberAttribute: resource.NumberAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -308,6 +331,29 @@ func TestDataSourceNumberAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.DataSourceNumberAttribute{
+				Name: "test_attribute",
+				NumberAttribute: datasource.NumberAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.DataSourceNumberAttribute{
+				Name: "test_attribute",
+				NumberAttribute: datasource.NumberAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
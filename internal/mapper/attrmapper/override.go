@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package attrmapper
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// typeOverrideList is the type override that converts a set attribute to a list attribute.
+	typeOverrideList = "list"
+
+	// typeOverrideSet is the type override that converts a list attribute to a set attribute.
+	typeOverrideSet = "set"
+)
+
+// unsupportedTypeOverrideError is returned when a type override is applied to an attribute that isn't a list or a set.
+func unsupportedTypeOverrideError(name string, overrideType string) error {
+	return fmt.Errorf("attribute '%s' can't be overridden to type '%s', only list and set attributes can be converted", name, overrideType)
+}
+
+// mergeOverrides merges the overrides of every override location that matches the attribute location, in the order of
+// util.SortedLocationKeys. Returns false if no override location matches.
+func mergeOverrides(location string, overrideMap map[string]explorer.Override) (explorer.Override, bool) {
+	var merged explorer.Override
+	matched := false
+
+	for _, key := range util.SortedLocationKeys(overrideMap) {
+		if !util.MatchLocation(key, location) {
+			continue
+		}
+
+		merged = mergeOverride(merged, overrideMap[key])
+		matched = true
+	}
+
+	return merged, matched
+}
+
+// mergeOverride merges an override into the overrides that precede it, with the same result as applying them in order, except
+// that validators and plan modifiers already added by a preceding override aren't added again.
+func mergeOverride(merged explorer.Override, override explorer.Override) explorer.Override {
+	if override.Description != "" {
+		merged.Description = override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		merged.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		merged.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		merged.DeprecationMessage = override.DeprecationMessage
+	}
+
+	if override.Type != "" {
+		merged.Type = override.Type
+	}
+
+	if override.UniqueItemsAsSet != nil {
+		merged.UniqueItemsAsSet = override.UniqueItemsAsSet
+	}
+
+	if override.RemoveValidators {
+		merged.RemoveValidators = true
+		merged.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		if !slices.ContainsFunc(merged.Validators, func(v *schema.CustomValidator) bool { return reflect.DeepEqual(v, validator) }) {
+			merged.Validators = append(merged.Validators, validator)
+		}
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		if !slices.ContainsFunc(merged.PlanModifiers, func(p *schema.CustomPlanModifier) bool { return reflect.DeepEqual(p, planModifier) }) {
+			merged.PlanModifiers = append(merged.PlanModifiers, planModifier)
+		}
+	}
+
+	if override.RemoveDefault {
+		merged.RemoveDefault = true
+		merged.Default = nil
+	}
+
+	if override.Default != nil {
+		merged.Default = override.Default
+	}
+
+	return merged
+}
+
+// validateDefaultComputability returns an error if the override makes an attribute with a default required or optional, as only
+// computed attributes can have a default. The default can be removed in the same override with `remove_default`.
+func validateDefaultComputability(name string, override explorer.Override, hasDefault bool) error {
+	if !hasDefault {
+		return nil
+	}
+
+	switch override.ComputedOptionalRequired {
+	case schema.Required, schema.Optional:
+		return fmt.Errorf("attribute '%s' has a default, so it can't be overridden to '%s' unless the default is removed with 'remove_default'", name, override.ComputedOptionalRequired)
+	}
+
+	return nil
+}
+
+// unconvertibleValidatorError is returned when a validator of an attribute can't be converted for a type override.
+func unconvertibleValidatorError(name string, overrideType string, err error) error {
+	return fmt.Errorf("attribute '%s' can't be overridden to type '%s': %w, remove the validators with 'remove_validators' and add "+
+		"%s validators with 'validators' in the override", name, overrideType, err, overrideType)
+}
+
+// setValidators converts the validators of a list attribute that is overridden to a set.
+func setValidators(name string, listValidators schema.ListValidators) (schema.SetValidators, error) {
+	var validators schema.SetValidators
+
+	for _, listValidator := range listValidators {
+		customValidator, err := frameworkvalidators.SetValidatorFromListValidator(listValidator.Custom)
+		if err != nil {
+			return nil, unconvertibleValidatorError(name, typeOverrideSet, err)
+		}
+
+		if customValidator == nil {
+			continue
+		}
+
+		validators = append(validators, schema.SetValidator{
+			Custom: customValidator,
+		})
+	}
+
+	return validators, nil
+}
+
+// listValidators converts the validators of a set attribute that is overridden to a list.
+func listValidators(name string, setValidators schema.SetValidators) (schema.ListValidators, error) {
+	var validators schema.ListValidators
+
+	for _, setValidator := range setValidators {
+		customValidator, err := frameworkvalidators.ListValidatorFromSetValidator(setValidator.Custom)
+		if err != nil {
+			return nil, unconvertibleValidatorError(name, typeOverrideList, err)
+		}
+
+		if customValidator == nil {
+			continue
+		}
+
+		validators = append(validators, schema.ListValidator{
+			Custom: customValidator,
+		})
+	}
+
+	return validators, nil
+}
@@ -8,9 +8,14 @@ import (
 )
 
 type ProviderAttribute interface {
+	GetName() string
 	ToSpec() provider.Attribute
 }
 
+type ProviderNestedAttribute interface {
+	NestedAttributes() ProviderAttributes
+}
+
 type ProviderAttributes []ProviderAttribute
 
 func (attributes ProviderAttributes) ToSpec() []provider.Attribute {
@@ -21,3 +26,31 @@ func (attributes ProviderAttributes) ToSpec() []provider.Attribute {
 
 	return specAttributes
 }
+
+// IgnorePaths returns the dot-separated location of every attribute that can be ignored in the generator config. In addition to
+// attributes and nested attributes, this includes the attributes of object element types in collections, which can be ignored
+// but not overridden.
+func (attributes ProviderAttributes) IgnorePaths() []string {
+	paths := []string{}
+	for _, attribute := range attributes {
+		paths = append(paths, attribute.GetName())
+
+		var nestedPaths []string
+		switch a := attribute.(type) {
+		case *ProviderListAttribute:
+			nestedPaths = elementTypePaths(a.ElementType)
+		case *ProviderMapAttribute:
+			nestedPaths = elementTypePaths(a.ElementType)
+		case *ProviderSetAttribute:
+			nestedPaths = elementTypePaths(a.ElementType)
+		case ProviderNestedAttribute:
+			nestedPaths = a.NestedAttributes().IgnorePaths()
+		}
+
+		for _, nestedPath := range nestedPaths {
+			paths = append(paths, attribute.GetName()+"."+nestedPath)
+		}
+	}
+
+	return paths
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 )
 
@@ -20,6 +21,7 @@ type ResourceAttribute interface {
 
 type ResourceNestedAttribute interface {
 	ApplyNestedOverride([]string, explorer.Override) (ResourceAttribute, error)
+	NestedAttributes() ResourceAttributes
 }
 
 type ResourceAttributes []ResourceAttribute
@@ -67,11 +69,83 @@ func (attributes ResourceAttributes) ToSpec() []resource.Attribute {
 	return specAttributes
 }
 
+// ResolveDefaults maps the defaults decoded from the OpenAPI spec of collection and object attributes, including nested
+// attributes, to static defaults. The defaults can only be mapped after the create and read schemas are merged, as the type of
+// a nested object includes every nested attribute. Required attributes with a default become computed and optional. Defaults
+// that don't match the type of the attribute, such as nested objects with custom types, are ignored and returned as an error.
+func (attributes ResourceAttributes) ResolveDefaults() error {
+	var errResult error
+	for _, attribute := range attributes {
+		if nestedAttribute, ok := attribute.(ResourceNestedAttribute); ok {
+			errResult = errors.Join(errResult, nestedAttribute.NestedAttributes().ResolveDefaults())
+		}
+
+		if defaultAttribute, ok := attribute.(resourceAttributeWithDefaultValue); ok {
+			errResult = errors.Join(errResult, defaultAttribute.resolveDefault())
+		}
+	}
+
+	return errResult
+}
+
+// Paths returns the dot-separated location of every attribute, including nested attributes, in the same format as
+// overrides in the generator config.
+func (attributes ResourceAttributes) Paths() []string {
+	paths := []string{}
+	for _, attribute := range attributes {
+		paths = append(paths, attribute.GetName())
+
+		if nestedAttribute, ok := attribute.(ResourceNestedAttribute); ok {
+			for _, nestedPath := range nestedAttribute.NestedAttributes().Paths() {
+				paths = append(paths, attribute.GetName()+"."+nestedPath)
+			}
+		}
+	}
+
+	return paths
+}
+
+// IgnorePaths returns the dot-separated location of every attribute that can be ignored in the generator config. In addition to
+// attributes and nested attributes, this includes the attributes of object element types in collections, which can be ignored
+// but not overridden.
+func (attributes ResourceAttributes) IgnorePaths() []string {
+	paths := []string{}
+	for _, attribute := range attributes {
+		paths = append(paths, attribute.GetName())
+
+		var nestedPaths []string
+		switch a := attribute.(type) {
+		case *ResourceListAttribute:
+			nestedPaths = elementTypePaths(a.ElementType)
+		case *ResourceMapAttribute:
+			nestedPaths = elementTypePaths(a.ElementType)
+		case *ResourceSetAttribute:
+			nestedPaths = elementTypePaths(a.ElementType)
+		case ResourceNestedAttribute:
+			nestedPaths = a.NestedAttributes().IgnorePaths()
+		}
+
+		for _, nestedPath := range nestedPaths {
+			paths = append(paths, attribute.GetName()+"."+nestedPath)
+		}
+	}
+
+	return paths
+}
+
+// ApplyOverrides applies the overrides to every attribute with a location that matches the override locations. The overrides
+// that match an attribute are merged, in the order of util.SortedLocationKeys, so an attribute that matches multiple location
+// patterns is only overridden once.
 func (attributes ResourceAttributes) ApplyOverrides(overrideMap map[string]explorer.Override) (ResourceAttributes, error) {
 	var errResult error
-	for key, override := range overrideMap {
+	for _, location := range attributes.Paths() {
+		override, ok := mergeOverrides(location, overrideMap)
+		if !ok {
+			continue
+		}
+
 		var err error
-		attributes, err = attributes.ApplyOverride(strings.Split(key, "."), override)
+		attributes, err = attributes.ApplyOverride(strings.Split(location, util.LocationSeparator), override)
 		errResult = errors.Join(errResult, err)
 	}
 
@@ -4,6 +4,7 @@
 package attrmapper_test
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 )
 
 func TestResourceAttributes_Merge(t *testing.T) {
@@ -354,6 +356,260 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 				},
 			},
 		},
+		"matching nested type override": {
+			overrides: map[string]explorer.Override{
+				"nested_attribute.list_attribute": {
+					ComputedOptionalRequired: schema.ComputedOptional,
+					Type:                     "set",
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "nested_attribute",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceListAttribute{
+							Name: "list_attribute",
+							ListAttribute: resource.ListAttribute{
+								ComputedOptionalRequired: schema.Required,
+								ElementType: schema.ElementType{
+									String: &schema.StringType{},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "nested_attribute",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSetAttribute{
+							Name: "list_attribute",
+							SetAttribute: resource.SetAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								ElementType: schema.ElementType{
+									String: &schema.StringType{},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+		"matching override patterns": {
+			overrides: map[string]explorer.Override{
+				"**.etag": {
+					Description: "new etag description",
+				},
+				"single_nested.*": {
+					Description: "new nested description",
+				},
+				"single_nested.etag": {
+					Description: "new nested etag description",
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "etag",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "etag",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Computed,
+							},
+						},
+						&attrmapper.ResourceStringAttribute{
+							Name: "name",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "etag",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+						Description:              pointer("new etag description"),
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "etag",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Computed,
+								Description:              pointer("new nested etag description"),
+							},
+						},
+						&attrmapper.ResourceStringAttribute{
+							Name: "name",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+								Description:              pointer("new nested description"),
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+		"overlapping override patterns": {
+			overrides: map[string]explorer.Override{
+				"*.etag": {
+					Validators: []*schema.CustomValidator{
+						{
+							SchemaDefinition: "validators.ETag()",
+						},
+					},
+					PlanModifiers: []*schema.CustomPlanModifier{
+						{
+							SchemaDefinition: "stringplanmodifier.UseStateForUnknown()",
+						},
+					},
+				},
+				"**.etag": {
+					Validators: []*schema.CustomValidator{
+						{
+							SchemaDefinition: "validators.ETag()",
+						},
+					},
+					PlanModifiers: []*schema.CustomPlanModifier{
+						{
+							SchemaDefinition: "stringplanmodifier.UseStateForUnknown()",
+						},
+					},
+				},
+				"single_nested.etag": {
+					Validators: []*schema.CustomValidator{
+						{
+							SchemaDefinition: "validators.ETag()",
+						},
+						{
+							SchemaDefinition: "validators.WeakETag()",
+						},
+					},
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "etag",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Computed,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "single_nested",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "etag",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Computed,
+								Validators: schema.StringValidators{
+									{
+										Custom: &schema.CustomValidator{
+											SchemaDefinition: "validators.ETag()",
+										},
+									},
+									{
+										Custom: &schema.CustomValidator{
+											SchemaDefinition: "validators.WeakETag()",
+										},
+									},
+								},
+								PlanModifiers: schema.StringPlanModifiers{
+									{
+										Custom: &schema.CustomPlanModifier{
+											SchemaDefinition: "stringplanmodifier.UseStateForUnknown()",
+										},
+									},
+								},
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+		},
+		"overlapping override patterns with remove_validators": {
+			overrides: map[string]explorer.Override{
+				"**.etag": {
+					Validators: []*schema.CustomValidator{
+						{
+							SchemaDefinition: "validators.ETag()",
+						},
+					},
+				},
+				"etag": {
+					RemoveValidators: true,
+					Validators: []*schema.CustomValidator{
+						{
+							SchemaDefinition: "validators.WeakETag()",
+						},
+					},
+				},
+			},
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "etag",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Validators: schema.StringValidators{
+							{
+								Custom: frameworkvalidators.StringValidatorLengthAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "etag",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Validators: schema.StringValidators{
+							{
+								Custom: &schema.CustomValidator{
+									SchemaDefinition: "validators.WeakETag()",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -368,3 +624,169 @@ func TestResourceAttributes_ApplyOverrides(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceAttributes_ApplyOverrides_DefaultComputability(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes       attrmapper.ResourceAttributes
+		overrides        map[string]explorer.Override
+		expectedErrRegex string
+	}{
+		"required with default": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_attribute",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.StringDefault{
+							Static: pointer("default"),
+						},
+					},
+				},
+			},
+			overrides: map[string]explorer.Override{
+				"string_attribute": {
+					ComputedOptionalRequired: schema.Required,
+				},
+			},
+			expectedErrRegex: `attribute 'string_attribute' has a default, so it can't be overridden to 'required'`,
+		},
+		"optional with default value": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListAttribute{
+					Name: "list_attribute",
+					ListAttribute: resource.ListAttribute{
+						ComputedOptionalRequired: schema.Required,
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+					},
+					DefaultValue: []any{"default"},
+				},
+			},
+			overrides: map[string]explorer.Override{
+				"list_attribute": {
+					ComputedOptionalRequired: schema.Optional,
+				},
+			},
+			expectedErrRegex: `attribute 'list_attribute' has a default, so it can't be overridden to 'optional'`,
+		},
+		"optional with override default": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceBoolAttribute{
+					Name: "bool_attribute",
+					BoolAttribute: resource.BoolAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+			},
+			overrides: map[string]explorer.Override{
+				"bool_attribute": {
+					ComputedOptionalRequired: schema.Optional,
+					Default: &schema.CustomDefault{
+						SchemaDefinition: "booldefault.StaticBool(true)",
+					},
+				},
+			},
+			expectedErrRegex: `attribute 'bool_attribute' has a default, so it can't be overridden to 'optional'`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		errRegex := regexp.MustCompile(testCase.expectedErrRegex)
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := testCase.attributes.ApplyOverrides(testCase.overrides)
+			if err == nil {
+				t.Fatalf("Expected err to match %q, got nil", testCase.expectedErrRegex)
+			}
+
+			if !errRegex.Match([]byte(err.Error())) {
+				t.Errorf("Expected error to match %q, got %q", testCase.expectedErrRegex, err.Error())
+			}
+		})
+	}
+}
+
+func TestResourceAttributes_ApplyOverrides_TypeValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributes       attrmapper.ResourceAttributes
+		overrides        map[string]explorer.Override
+		expectedErrRegex string
+	}{
+		"list custom validator to set": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListAttribute{
+					Name: "list_attribute",
+					ListAttribute: resource.ListAttribute{
+						ComputedOptionalRequired: schema.Required,
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+						Validators: schema.ListValidators{
+							{
+								Custom: frameworkvalidators.ListValidatorSizeAtLeast(1),
+							},
+							{
+								Custom: &schema.CustomValidator{
+									SchemaDefinition: "validators.SortedList()",
+								},
+							},
+						},
+					},
+				},
+			},
+			overrides: map[string]explorer.Override{
+				"list_attribute": {
+					Type: "set",
+				},
+			},
+			expectedErrRegex: `attribute 'list_attribute' can't be overridden to type 'set': validator 'validators.SortedList\(\)' has no setvalidator package equivalent`,
+		},
+		"set nested custom validator to list": {
+			attributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSetNestedAttribute{
+					Name: "set_nested_attribute",
+					SetNestedAttribute: resource.SetNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Validators: schema.SetValidators{
+							{
+								Custom: &schema.CustomValidator{
+									SchemaDefinition: "validators.UniqueNames()",
+								},
+							},
+						},
+					},
+				},
+			},
+			overrides: map[string]explorer.Override{
+				"set_nested_attribute": {
+					Type: "list",
+				},
+			},
+			expectedErrRegex: `attribute 'set_nested_attribute' can't be overridden to type 'list': validator 'validators.UniqueNames\(\)' has no listvalidator package equivalent`,
+		},
+	}
+	for name, testCase := range testCases {
+
+		errRegex := regexp.MustCompile(testCase.expectedErrRegex)
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := testCase.attributes.ApplyOverrides(testCase.overrides)
+			if err == nil {
+				t.Fatalf("Expected err to match %q, got nil", testCase.expectedErrRegex)
+			}
+
+			if !errRegex.Match([]byte(err.Error())) {
+				t.Errorf("Expected error to match %q, got %q", testCase.expectedErrRegex, err.Error())
+			}
+		})
+	}
+}
@@ -5,16 +5,21 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceSetAttribute struct {
 	resource.SetAttribute
 
 	Name string
+
+	// DefaultValue is the default decoded from the OpenAPI spec, see ResolveDefaults.
+	DefaultValue any
 }
 
 func (a *ResourceSetAttribute) GetName() string {
@@ -37,11 +42,95 @@ func (a *ResourceSetAttribute) Merge(mergeAttribute ResourceAttribute) (Resource
 }
 
 func (a *ResourceSetAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	// Convert the attribute first, so the remaining overrides are applied to the converted attribute
+	if override.Type == typeOverrideList {
+		// Validators removed by the override don't need to be converted
+		if override.RemoveValidators {
+			a.Validators = nil
+		}
+
+		listAttribute, err := a.toListAttribute()
+		if err != nil {
+			return a, err
+		}
+
+		return listAttribute.ApplyOverride(override)
+	}
+
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.SetValidator{
+			Custom: validator,
+		})
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		a.PlanModifiers = append(a.PlanModifiers, schema.SetPlanModifier{
+			Custom: planModifier,
+		})
+	}
+
+	if override.RemoveDefault {
+		a.Default = nil
+		a.DefaultValue = nil
+	}
+
+	if override.Default != nil {
+		a.Default = &schema.SetDefault{
+			Custom: override.Default,
+		}
+		a.DefaultValue = nil
+	}
+
+	if err := validateDefaultComputability(a.Name, override, a.Default != nil || a.DefaultValue != nil); err != nil {
+		return a, err
+	}
 
 	return a, nil
 }
 
+// toListAttribute converts the set attribute to a list attribute for a type override. Validators are converted to the
+// listvalidator package, and custom types and plan modifiers are dropped, as they're specific to sets.
+func (a *ResourceSetAttribute) toListAttribute() (*ResourceListAttribute, error) {
+	validators, err := listValidators(a.Name, a.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceListAttribute{
+		Name: a.Name,
+		ListAttribute: resource.ListAttribute{
+			AssociatedExternalType:   a.AssociatedExternalType,
+			ComputedOptionalRequired: a.ComputedOptionalRequired,
+			DeprecationMessage:       a.DeprecationMessage,
+			Description:              a.Description,
+			ElementType:              a.ElementType,
+			Sensitive:                a.Sensitive,
+			Validators:               validators,
+		},
+		DefaultValue: a.DefaultValue,
+	}, nil
+}
+
 func (a *ResourceSetAttribute) ToSpec() resource.Attribute {
 	return resource.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -49,6 +138,27 @@ func (a *ResourceSetAttribute) ToSpec() resource.Attribute {
 	}
 }
 
+func (a *ResourceSetAttribute) resolveDefault() error {
+	if a.DefaultValue == nil {
+		return nil
+	}
+
+	customDefault, err := frameworkdefaults.SetDefaultStaticValue(a.ElementType, a.DefaultValue)
+	if err != nil {
+		return invalidDefaultError(a.Name, err)
+	}
+
+	if a.ComputedOptionalRequired == schema.Required {
+		a.ComputedOptionalRequired = schema.ComputedOptional
+	}
+
+	a.Default = &schema.SetDefault{
+		Custom: customDefault,
+	}
+
+	return nil
+}
+
 type DataSourceSetAttribute struct {
 	datasource.SetAttribute
 
@@ -75,11 +185,72 @@ func (a *DataSourceSetAttribute) Merge(mergeAttribute DataSourceAttribute) (Data
 }
 
 func (a *DataSourceSetAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	// Convert the attribute first, so the remaining overrides are applied to the converted attribute
+	if override.Type == typeOverrideList {
+		// Validators removed by the override don't need to be converted
+		if override.RemoveValidators {
+			a.Validators = nil
+		}
+
+		listAttribute, err := a.toListAttribute()
+		if err != nil {
+			return a, err
+		}
+
+		return listAttribute.ApplyOverride(override)
+	}
+
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.SetValidator{
+			Custom: validator,
+		})
+	}
 
 	return a, nil
 }
 
+// toListAttribute converts the set attribute to a list attribute for a type override. Validators are converted to the
+// listvalidator package, and custom types are dropped, as they're specific to sets.
+func (a *DataSourceSetAttribute) toListAttribute() (*DataSourceListAttribute, error) {
+	validators, err := listValidators(a.Name, a.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataSourceListAttribute{
+		Name: a.Name,
+		ListAttribute: datasource.ListAttribute{
+			AssociatedExternalType:   a.AssociatedExternalType,
+			ComputedOptionalRequired: a.ComputedOptionalRequired,
+			DeprecationMessage:       a.DeprecationMessage,
+			Description:              a.Description,
+			ElementType:              a.ElementType,
+			Sensitive:                a.Sensitive,
+			Validators:               validators,
+		},
+	}, nil
+}
+
 func (a *DataSourceSetAttribute) ToSpec() datasource.Attribute {
 	return datasource.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -93,6 +264,10 @@ type ProviderSetAttribute struct {
 	Name string
 }
 
+func (a *ProviderSetAttribute) GetName() string {
+	return a.Name
+}
+
 func (a *ProviderSetAttribute) ToSpec() provider.Attribute {
 	return provider.Attribute{
 		Name: util.TerraformIdentifier(a.Name),
@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceSetNestedAttribute struct {
@@ -16,6 +18,9 @@ type ResourceSetNestedAttribute struct {
 
 	Name         string
 	NestedObject ResourceNestedAttributeObject
+
+	// DefaultValue is the default decoded from the OpenAPI spec, see ResolveDefaults.
+	DefaultValue any
 }
 
 func (a *ResourceSetNestedAttribute) GetName() string {
@@ -38,11 +43,94 @@ func (a *ResourceSetNestedAttribute) Merge(mergeAttribute ResourceAttribute) (Re
 }
 
 func (a *ResourceSetNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	// Convert the attribute first, so the remaining overrides are applied to the converted attribute
+	if override.Type == typeOverrideList {
+		// Validators removed by the override don't need to be converted
+		if override.RemoveValidators {
+			a.Validators = nil
+		}
+
+		listNestedAttribute, err := a.toListNestedAttribute()
+		if err != nil {
+			return a, err
+		}
+
+		return listNestedAttribute.ApplyOverride(override)
+	}
+
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.SetValidator{
+			Custom: validator,
+		})
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		a.PlanModifiers = append(a.PlanModifiers, schema.SetPlanModifier{
+			Custom: planModifier,
+		})
+	}
+
+	if override.RemoveDefault {
+		a.Default = nil
+		a.DefaultValue = nil
+	}
+
+	if override.Default != nil {
+		a.Default = &schema.SetDefault{
+			Custom: override.Default,
+		}
+		a.DefaultValue = nil
+	}
+
+	if err := validateDefaultComputability(a.Name, override, a.Default != nil || a.DefaultValue != nil); err != nil {
+		return a, err
+	}
 
 	return a, nil
 }
 
+// toListNestedAttribute converts the set nested attribute to a list nested attribute for a type override. Validators are
+// converted to the listvalidator package, and custom types and plan modifiers are dropped, as they're specific to sets.
+func (a *ResourceSetNestedAttribute) toListNestedAttribute() (*ResourceListNestedAttribute, error) {
+	validators, err := listValidators(a.Name, a.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ResourceListNestedAttribute{
+		Name:         a.Name,
+		NestedObject: a.NestedObject,
+		ListNestedAttribute: resource.ListNestedAttribute{
+			ComputedOptionalRequired: a.ComputedOptionalRequired,
+			DeprecationMessage:       a.DeprecationMessage,
+			Description:              a.Description,
+			Sensitive:                a.Sensitive,
+			Validators:               validators,
+		},
+		DefaultValue: a.DefaultValue,
+	}, nil
+}
+
 func (a *ResourceSetNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (ResourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
@@ -50,6 +138,10 @@ func (a *ResourceSetNestedAttribute) ApplyNestedOverride(path []string, override
 	return a, err
 }
 
+func (a *ResourceSetNestedAttribute) NestedAttributes() ResourceAttributes {
+	return a.NestedObject.Attributes
+}
+
 func (a *ResourceSetNestedAttribute) ToSpec() resource.Attribute {
 	a.SetNestedAttribute.NestedObject = resource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -61,6 +153,27 @@ func (a *ResourceSetNestedAttribute) ToSpec() resource.Attribute {
 	}
 }
 
+func (a *ResourceSetNestedAttribute) resolveDefault() error {
+	if a.DefaultValue == nil {
+		return nil
+	}
+
+	customDefault, err := frameworkdefaults.SetDefaultStaticValue(nestedObjectElementType(a.ToSpec().SetNested.NestedObject), a.DefaultValue)
+	if err != nil {
+		return invalidDefaultError(a.Name, err)
+	}
+
+	if a.ComputedOptionalRequired == schema.Required {
+		a.ComputedOptionalRequired = schema.ComputedOptional
+	}
+
+	a.Default = &schema.SetDefault{
+		Custom: customDefault,
+	}
+
+	return nil
+}
+
 type DataSourceSetNestedAttribute struct {
 	datasource.SetNestedAttribute
 
@@ -88,11 +201,71 @@ func (a *DataSourceSetNestedAttribute) Merge(mergeAttribute DataSourceAttribute)
 }
 
 func (a *DataSourceSetNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	// Convert the attribute first, so the remaining overrides are applied to the converted attribute
+	if override.Type == typeOverrideList {
+		// Validators removed by the override don't need to be converted
+		if override.RemoveValidators {
+			a.Validators = nil
+		}
+
+		listNestedAttribute, err := a.toListNestedAttribute()
+		if err != nil {
+			return a, err
+		}
+
+		return listNestedAttribute.ApplyOverride(override)
+	}
+
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.SetValidator{
+			Custom: validator,
+		})
+	}
 
 	return a, nil
 }
 
+// toListNestedAttribute converts the set nested attribute to a list nested attribute for a type override. Validators are
+// converted to the listvalidator package, and custom types are dropped, as they're specific to sets.
+func (a *DataSourceSetNestedAttribute) toListNestedAttribute() (*DataSourceListNestedAttribute, error) {
+	validators, err := listValidators(a.Name, a.Validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DataSourceListNestedAttribute{
+		Name:         a.Name,
+		NestedObject: a.NestedObject,
+		ListNestedAttribute: datasource.ListNestedAttribute{
+			ComputedOptionalRequired: a.ComputedOptionalRequired,
+			DeprecationMessage:       a.DeprecationMessage,
+			Description:              a.Description,
+			Sensitive:                a.Sensitive,
+			Validators:               validators,
+		},
+	}, nil
+}
+
 func (a *DataSourceSetNestedAttribute) ApplyNestedOverride(path []string, override explorer.Override) (DataSourceAttribute, error) {
 	var err error
 	a.NestedObject.Attributes, err = a.NestedObject.Attributes.ApplyOverride(path, override)
@@ -100,6 +273,10 @@ func (a *DataSourceSetNestedAttribute) ApplyNestedOverride(path []string, overri
 	return a, err
 }
 
+func (a *DataSourceSetNestedAttribute) NestedAttributes() DataSourceAttributes {
+	return a.NestedObject.Attributes
+}
+
 func (a *DataSourceSetNestedAttribute) ToSpec() datasource.Attribute {
 	a.SetNestedAttribute.NestedObject = datasource.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
@@ -118,6 +295,14 @@ type ProviderSetNestedAttribute struct {
 	NestedObject ProviderNestedAttributeObject
 }
 
+func (a *ProviderSetNestedAttribute) GetName() string {
+	return a.Name
+}
+
+func (a *ProviderSetNestedAttribute) NestedAttributes() ProviderAttributes {
+	return a.NestedObject.Attributes
+}
+
 func (a *ProviderSetNestedAttribute) ToSpec() provider.Attribute {
 	a.SetNestedAttribute.NestedObject = provider.NestedAttributeObject{
 		Attributes: a.NestedObject.Attributes.ToSpec(),
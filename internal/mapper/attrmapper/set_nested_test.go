@@ -409,6 +409,47 @@ func TestResourceSetNestedAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override type to list": {
+			attribute: attrmapper.ResourceSetNestedAttribute{
+				Name: "test_attribute",
+				NestedObject: attrmapper.ResourceNestedAttributeObject{
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "nested_string",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+							},
+						},
+					},
+				},
+				SetNestedAttribute: resource.SetNestedAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				Sensitive: pointer(true),
+				Type:      "list",
+			},
+			expectedAttribute: &attrmapper.ResourceListNestedAttribute{
+				Name: "test_attribute",
+				NestedObject: attrmapper.ResourceNestedAttributeObject{
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "nested_string",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+							},
+						},
+					},
+				},
+				ListNestedAttribute: resource.ListNestedAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -967,6 +1008,47 @@ func TestDataSourceSetNestedAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override type to list": {
+			attribute: attrmapper.DataSourceSetNestedAttribute{
+				Name: "test_attribute",
+				NestedObject: attrmapper.DataSourceNestedAttributeObject{
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceStringAttribute{
+							Name: "nested_string",
+							StringAttribute: datasource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+							},
+						},
+					},
+				},
+				SetNestedAttribute: datasource.SetNestedAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				Sensitive: pointer(true),
+				Type:      "list",
+			},
+			expectedAttribute: &attrmapper.DataSourceListNestedAttribute{
+				Name: "test_attribute",
+				NestedObject: attrmapper.DataSourceNestedAttributeObject{
+					Attributes: attrmapper.DataSourceAttributes{
+						&attrmapper.DataSourceStringAttribute{
+							Name: "nested_string",
+							StringAttribute: datasource.StringAttribute{
+								ComputedOptionalRequired: schema.Required,
+							},
+						},
+					},
+				},
+				ListNestedAttribute: datasource.ListNestedAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
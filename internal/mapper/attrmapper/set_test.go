@@ -13,6 +13,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 )
 
 func TestResourceSetAttribute_Merge(t *testing.T) {
@@ -514,6 +515,39 @@ func TestResourceSetAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override type to list": {
+			attribute: attrmapper.ResourceSetAttribute{
+				Name: "test_attribute",
+				SetAttribute: resource.SetAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.SetValidators{
+						{
+							Custom: frameworkvalidators.SetValidatorSizeBetween(1, 5),
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				Type: "list",
+			},
+			expectedAttribute: &attrmapper.ResourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: resource.ListAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.ListValidators{
+						{
+							Custom: frameworkvalidators.ListValidatorSizeBetween(1, 5),
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -1028,6 +1062,39 @@ func TestDataSourceSetAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override type to list": {
+			attribute: attrmapper.DataSourceSetAttribute{
+				Name: "test_attribute",
+				SetAttribute: datasource.SetAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.SetValidators{
+						{
+							Custom: frameworkvalidators.SetValidatorSizeBetween(1, 5),
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				Type: "list",
+			},
+			expectedAttribute: &attrmapper.DataSourceListAttribute{
+				Name: "test_attribute",
+				ListAttribute: datasource.ListAttribute{
+					ComputedOptionalRequired: schema.Required,
+					ElementType: schema.ElementType{
+						String: &schema.StringType{},
+					},
+					Validators: schema.ListValidators{
+						{
+							Custom: frameworkvalidators.ListValidatorSizeBetween(1, 5),
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -5,10 +5,12 @@ package attrmapper
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
 
 type ResourceSingleNestedAttribute struct {
@@ -16,6 +18,9 @@ type ResourceSingleNestedAttribute struct {
 
 	Name       string
 	Attributes ResourceAttributes
+
+	// DefaultValue is the default decoded from the OpenAPI spec, see ResolveDefaults.
+	DefaultValue any
 }
 
 func (a *ResourceSingleNestedAttribute) GetName() string {
@@ -38,7 +43,57 @@ func (a *ResourceSingleNestedAttribute) Merge(mergeAttribute ResourceAttribute)
 }
 
 func (a *ResourceSingleNestedAttribute) ApplyOverride(override explorer.Override) (ResourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.ObjectValidator{
+			Custom: validator,
+		})
+	}
+
+	for _, planModifier := range override.PlanModifiers {
+		a.PlanModifiers = append(a.PlanModifiers, schema.ObjectPlanModifier{
+			Custom: planModifier,
+		})
+	}
+
+	if override.RemoveDefault {
+		a.Default = nil
+		a.DefaultValue = nil
+	}
+
+	if override.Default != nil {
+		a.Default = &schema.ObjectDefault{
+			Custom: override.Default,
+		}
+		a.DefaultValue = nil
+	}
+
+	if err := validateDefaultComputability(a.Name, override, a.Default != nil || a.DefaultValue != nil); err != nil {
+		return a, err
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -50,6 +105,10 @@ func (a *ResourceSingleNestedAttribute) ApplyNestedOverride(path []string, overr
 	return a, err
 }
 
+func (a *ResourceSingleNestedAttribute) NestedAttributes() ResourceAttributes {
+	return a.Attributes
+}
+
 func (a *ResourceSingleNestedAttribute) ToSpec() resource.Attribute {
 	a.SingleNestedAttribute.Attributes = a.Attributes.ToSpec()
 
@@ -59,6 +118,27 @@ func (a *ResourceSingleNestedAttribute) ToSpec() resource.Attribute {
 	}
 }
 
+func (a *ResourceSingleNestedAttribute) resolveDefault() error {
+	if a.DefaultValue == nil {
+		return nil
+	}
+
+	customDefault, err := frameworkdefaults.ObjectDefaultStaticValue(objectAttributeTypes(a.ToSpec().SingleNested.Attributes), a.DefaultValue)
+	if err != nil {
+		return invalidDefaultError(a.Name, err)
+	}
+
+	if a.ComputedOptionalRequired == schema.Required {
+		a.ComputedOptionalRequired = schema.ComputedOptional
+	}
+
+	a.Default = &schema.ObjectDefault{
+		Custom: customDefault,
+	}
+
+	return nil
+}
+
 type DataSourceSingleNestedAttribute struct {
 	datasource.SingleNestedAttribute
 
@@ -86,7 +166,35 @@ func (a *DataSourceSingleNestedAttribute) Merge(mergeAttribute DataSourceAttribu
 }
 
 func (a *DataSourceSingleNestedAttribute) ApplyOverride(override explorer.Override) (DataSourceAttribute, error) {
-	a.Description = &override.Description
+	if override.Description != "" {
+		a.Description = &override.Description
+	}
+
+	if override.ComputedOptionalRequired != "" {
+		a.ComputedOptionalRequired = override.ComputedOptionalRequired
+	}
+
+	if override.Sensitive != nil {
+		a.Sensitive = override.Sensitive
+	}
+
+	if override.DeprecationMessage != "" {
+		a.DeprecationMessage = &override.DeprecationMessage
+	}
+
+	if override.RemoveValidators {
+		a.Validators = nil
+	}
+
+	for _, validator := range override.Validators {
+		a.Validators = append(a.Validators, schema.ObjectValidator{
+			Custom: validator,
+		})
+	}
+
+	if override.Type != "" {
+		return a, unsupportedTypeOverrideError(a.Name, override.Type)
+	}
 
 	return a, nil
 }
@@ -98,6 +206,10 @@ func (a *DataSourceSingleNestedAttribute) ApplyNestedOverride(path []string, ove
 	return a, err
 }
 
+func (a *DataSourceSingleNestedAttribute) NestedAttributes() DataSourceAttributes {
+	return a.Attributes
+}
+
 func (a *DataSourceSingleNestedAttribute) ToSpec() datasource.Attribute {
 	a.SingleNestedAttribute.Attributes = a.Attributes.ToSpec()
 
@@ -114,6 +226,14 @@ type ProviderSingleNestedAttribute struct {
 	Attributes ProviderAttributes
 }
 
+func (a *ProviderSingleNestedAttribute) GetName() string {
+	return a.Name
+}
+
+func (a *ProviderSingleNestedAttribute) NestedAttributes() ProviderAttributes {
+	return a.Attributes
+}
+
 func (a *ProviderSingleNestedAttribute) ToSpec() provider.Attribute {
 	a.SingleNestedAttribute.Attributes = a.Attributes.ToSpec()
 
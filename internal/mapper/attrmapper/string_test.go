@@ -154,6 +154,81 @@ func TestResourceStringAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
+		"override validators and plan modifiers, remove default": {
+			attribute: attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					Default: &schema.StringDefault{
+						Static: pointer("old default"),
+					},
+					Validators: schema.StringValidators{
+						{
+							Custom: &schema.CustomValidator{
+								SchemaDefinition: "stringvalidator.LengthAtLeast(1)",
+							},
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				RemoveValidators: true,
+				Validators: []*schema.CustomValidator{
+					{
+						SchemaDefinition: "stringvalidator.LengthAtMost(64)",
+					},
+				},
+				PlanModifiers: []*schema.CustomPlanModifier{
+					{
+						SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+					},
+				},
+				RemoveDefault: true,
+			},
+			expectedAttribute: &attrmapper.ResourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: resource.StringAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					PlanModifiers: schema.StringPlanModifiers{
+						{
+							Custom: &schema.CustomPlanModifier{
+								SchemaDefinition: "stringplanmodifier.RequiresReplace()",
+							},
+						},
+					},
+					Validators: schema.StringValidators{
+						{
+							Custom: &schema.CustomValidator{
+								SchemaDefinition: "stringvalidator.LengthAtMost(64)",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -308,6 +383,69 @@ func TestDataSourceStringAttribute_ApplyOverride(t *testing.T) {
 				},
 			},
 		},
+		"override computability, sensitivity, and deprecation": {
+			attribute: attrmapper.DataSourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: datasource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Description:              pointer("old description"),
+				},
+			},
+			override: explorer.Override{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Sensitive:                pointer(true),
+				DeprecationMessage:       "use new_attribute instead",
+			},
+			expectedAttribute: &attrmapper.DataSourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: datasource.StringAttribute{
+					ComputedOptionalRequired: schema.ComputedOptional,
+					DeprecationMessage:       pointer("use new_attribute instead"),
+					Description:              pointer("old description"),
+					Sensitive:                pointer(true),
+				},
+			},
+		},
+		"override validators": {
+			attribute: attrmapper.DataSourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: datasource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Validators: schema.StringValidators{
+						{
+							Custom: &schema.CustomValidator{
+								SchemaDefinition: "stringvalidator.LengthAtLeast(1)",
+							},
+						},
+					},
+				},
+			},
+			override: explorer.Override{
+				Validators: []*schema.CustomValidator{
+					{
+						SchemaDefinition: "stringvalidator.LengthAtMost(64)",
+					},
+				},
+			},
+			expectedAttribute: &attrmapper.DataSourceStringAttribute{
+				Name: "test_attribute",
+				StringAttribute: datasource.StringAttribute{
+					ComputedOptionalRequired: schema.Required,
+					Validators: schema.StringValidators{
+						{
+							Custom: &schema.CustomValidator{
+								SchemaDefinition: "stringvalidator.LengthAtLeast(1)",
+							},
+						},
+						{
+							Custom: &schema.CustomValidator{
+								SchemaDefinition: "stringvalidator.LengthAtMost(64)",
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 	for name, testCase := range testCases {
 
@@ -63,3 +63,27 @@ func mergeObjectAttributeTypes(targetAttrTypes []schema.ObjectAttributeType, mer
 
 	return targetAttrTypes
 }
+
+// elementTypePaths returns the dot-separated location of every object attribute type within an element type, including object
+// attribute types nested in collections.
+func elementTypePaths(elemType schema.ElementType) []string {
+	switch {
+	case elemType.List != nil:
+		return elementTypePaths(elemType.List.ElementType)
+	case elemType.Map != nil:
+		return elementTypePaths(elemType.Map.ElementType)
+	case elemType.Set != nil:
+		return elementTypePaths(elemType.Set.ElementType)
+	case elemType.Object != nil:
+		paths := []string{}
+		for _, attrType := range elemType.Object.AttributeTypes {
+			paths = append(paths, attrType.Name)
+			for _, nestedPath := range elementTypePaths(util.CreateElementType(attrType)) {
+				paths = append(paths, attrType.Name+"."+nestedPath)
+			}
+		}
+		return paths
+	}
+
+	return nil
+}
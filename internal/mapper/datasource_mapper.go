@@ -4,6 +4,7 @@
 package mapper
 
 import (
+	"errors"
 	"fmt"
 	"log/slog"
 
@@ -25,8 +26,7 @@ type DataSourceMapper interface {
 
 type dataSourceMapper struct {
 	dataSources map[string]explorer.DataSource
-	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg         config.Config
 }
 
 func NewDataSourceMapper(dataSources map[string]explorer.DataSource, cfg config.Config) DataSourceMapper {
@@ -38,14 +38,23 @@ func NewDataSourceMapper(dataSources map[string]explorer.DataSource, cfg config.
 
 func (m dataSourceMapper) MapToIR(logger *slog.Logger) ([]datasource.DataSource, error) {
 	dataSourceSchemas := []datasource.DataSource{}
+	var errResult error
+
+	globalSchemaOpts := newGlobalSchemaOpts(m.cfg)
+	schemaDefaults := explorer.SchemaDefaults(m.cfg)
 
 	// Guarantee the order of processing
 	dataSourceNames := util.SortedKeys(m.dataSources)
 	for _, name := range dataSourceNames {
 		dataSource := m.dataSources[name]
+		dataSource.SchemaOptions = dataSource.SchemaOptions.WithDefaults(schemaDefaults)
 		dLogger := logger.With("data_source", name)
 
-		schema, err := generateDataSourceSchema(dLogger, name, dataSource)
+		schema, err := generateDataSourceSchema(dLogger, name, dataSource, globalSchemaOpts)
+		if errors.Is(err, ErrInvalidOverride) {
+			errResult = errors.Join(errResult, fmt.Errorf("data source '%s': %w", name, err))
+			continue
+		}
 		if err != nil {
 			log.WarnLogOnError(dLogger, err, "skipping data source schema mapping")
 			continue
@@ -57,26 +66,47 @@ func (m dataSourceMapper) MapToIR(logger *slog.Logger) ([]datasource.DataSource,
 		})
 	}
 
-	return dataSourceSchemas, nil
+	return dataSourceSchemas, errResult
 }
 
-func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explorer.DataSource) (*datasource.Schema, error) {
+func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explorer.DataSource, globalSchemaOpts oas.GlobalSchemaOpts) (*datasource.Schema, error) {
 	dataSourceSchema := &datasource.Schema{
 		Attributes: []datasource.Attribute{},
 	}
 
+	dataSourceAttributes, err := generateDataSourceAttributes(logger, name, dataSource, globalSchemaOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	dataSourceAttributes, err = dataSourceAttributes.ApplyOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOverride, err)
+	}
+
+	dataSourceSchema.Attributes = dataSourceAttributes.ToSpec()
+	return dataSourceSchema, nil
+}
+
+// generateDataSourceAttributes maps and merges all attributes of the data source, with ignores applied. Overrides are not applied.
+func generateDataSourceAttributes(logger *slog.Logger, name string, dataSource explorer.DataSource, globalSchemaOpts oas.GlobalSchemaOpts) (attrmapper.DataSourceAttributes, error) {
+	globalSchemaOpts.Logger = logger
+	setOverrides := uniqueItemsAsSets(dataSource.SchemaOptions.AttributeOptions.Overrides)
+	bodyAliases := propertyAliases(dataSource.ReadOpParameters(), dataSource.SchemaOptions.AttributeOptions.Aliases)
+
 	// ********************
 	// READ Response Body (required)
 	// ********************
 	logger.Debug("searching for read operation response body")
 
 	schemaOpts := oas.SchemaOpts{
-		Ignores: dataSource.SchemaOptions.Ignores,
+		Aliases:           bodyAliases,
+		Ignores:           dataSource.SchemaOptions.Ignores,
+		UniqueItemsAsSets: setOverrides,
 	}
-	globalSchemaOpts := oas.GlobalSchemaOpts{
-		OverrideComputability: schema.Computed,
-	}
-	readResponseSchema, err := oas.BuildSchemaFromResponse(dataSource.ReadOp, schemaOpts, globalSchemaOpts)
+	responseSchemaOpts := globalSchemaOpts
+	responseSchemaOpts.OverrideComputability = schema.Computed
+	readResponseSchema, err := oas.BuildSchemaFromResponse(dataSource.ReadOp, schemaOpts, responseSchemaOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -116,9 +146,10 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 		schemaOpts := oas.SchemaOpts{
 			Ignores:             dataSource.SchemaOptions.Ignores,
 			OverrideDescription: param.Description,
+			UniqueItemsAsSets:   setOverrides,
 		}
 
-		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, oas.GlobalSchemaOpts{})
+		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, globalSchemaOpts)
 		if schemaErr != nil {
 			log.WarnLogOnError(pLogger, schemaErr, "skipping mapping of read operation parameter")
 			continue
@@ -130,15 +161,15 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 		}
 
 		// Check for any aliases and replace the paramater name if found
-		paramName := param.Name
-		if aliasedName, ok := dataSource.SchemaOptions.AttributeOptions.Aliases[param.Name]; ok {
-			pLogger = pLogger.With("param_alias", aliasedName)
-			paramName = aliasedName
+		paramName := parameterAttributeName(param, dataSource.SchemaOptions.AttributeOptions.Aliases)
+		if paramName != param.Name {
+			pLogger = pLogger.With("param_alias", paramName)
 		}
 
 		if s.IsPropertyIgnored(paramName) {
 			continue
 		}
+		s.SchemaOpts.UniqueItemsAsSet = s.GetUniqueItemsAsSet(paramName)
 
 		parameterAttribute, schemaErr := s.BuildDataSourceAttribute(paramName, computability)
 		if schemaErr != nil {
@@ -152,9 +183,5 @@ func generateDataSourceSchema(logger *slog.Logger, name string, dataSource explo
 	// TODO: currently, no errors can be returned from merging, but in the future we should consider raising errors/warnings for unexpected scenarios, like type mismatches between attribute schemas
 	dataSourceAttributes, _ := readParameterAttributes.Merge(readResponseAttributes)
 
-	// TODO: handle error for overrides
-	dataSourceAttributes, _ = dataSourceAttributes.ApplyOverrides(dataSource.SchemaOptions.AttributeOptions.Overrides)
-
-	dataSourceSchema.Attributes = dataSourceAttributes.ToSpec()
-	return dataSourceSchema, nil
+	return dataSourceAttributes, nil
 }
@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults
+
+import "github.com/hashicorp/terraform-plugin-codegen-spec/code"
+
+const (
+	// CodeImportBasePath is the base code import path for the framework.
+	CodeImportBasePath = "github.com/hashicorp/terraform-plugin-framework"
+)
+
+var (
+	// AttrCodeImport is a single allocation of the framework attr package
+	// import, used for attr.Type and attr.Value.
+	AttrCodeImport code.Import = CodeImport("attr")
+
+	// TypesCodeImport is a single allocation of the framework types package
+	// import, used to create framework types and values.
+	TypesCodeImport code.Import = CodeImport("types")
+
+	// BigCodeImport is a single allocation of the Go standard library
+	// math/big package import, used to create *big.Float values.
+	BigCodeImport code.Import = code.Import{
+		Path: "math/big",
+	}
+)
+
+// CodeImport returns the framework code import for the given path.
+func CodeImport(packagePath string) code.Import {
+	return code.Import{
+		Path: CodeImportBasePath + "/" + packagePath,
+	}
+}
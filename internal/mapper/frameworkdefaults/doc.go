@@ -0,0 +1,10 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package frameworkdefaults contains functionality for mapping defaults onto
+// specification that uses the terraform-plugin-framework defaults packages.
+//
+// The specification only supports "static" defaults for primitive types, so
+// defaults of numbers, collections, and objects are written as "custom"
+// defaults, with a schema definition that creates the framework value.
+package frameworkdefaults
@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// ListDefaultPackage is the name of the list default package in the
+	// framework.
+	ListDefaultPackage = "listdefault"
+)
+
+var (
+	// ListDefaultCodeImport is a single allocation of the framework
+	// listdefault package import.
+	ListDefaultCodeImport code.Import = CodeImport("resource/schema/" + ListDefaultPackage)
+)
+
+// ListDefaultStaticValue returns a custom default mapped to the listdefault
+// package StaticValue function, with a list value of the given element type.
+// An error is returned if the value doesn't match the element type.
+func ListDefaultStaticValue(elementType schema.ElementType, value any) (*schema.CustomDefault, error) {
+	return staticDefault(ListDefaultCodeImport, ListDefaultPackage, schema.ElementType{
+		List: &schema.ListType{
+			ElementType: elementType,
+		},
+	}, value)
+}
@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
+)
+
+func TestListDefaultStaticValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   schema.ElementType
+		value         any
+		expected      *schema.CustomDefault
+		expectedError string
+	}{
+		"string": {
+			elementType: schema.ElementType{
+				String: &schema.StringType{},
+			},
+			value: []any{"one", "two \"quoted\""},
+			expected: &schema.CustomDefault{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/types",
+					},
+				},
+				SchemaDefinition: "listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{\n" +
+					"types.StringValue(\"one\"),\n" +
+					"types.StringValue(\"two \\\"quoted\\\"\"),\n" +
+					"}))",
+			},
+		},
+		"empty": {
+			elementType: schema.ElementType{
+				Int64: &schema.Int64Type{},
+			},
+			value: []any{},
+			expected: &schema.CustomDefault{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/types",
+					},
+				},
+				SchemaDefinition: "listdefault.StaticValue(types.ListValueMust(types.Int64Type, []attr.Value{\n" +
+					"}))",
+			},
+		},
+		"number": {
+			elementType: schema.ElementType{
+				Number: &schema.NumberType{},
+			},
+			value: []any{1, 2.5},
+			expected: &schema.CustomDefault{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/types",
+					},
+					{
+						Path: "math/big",
+					},
+				},
+				SchemaDefinition: "listdefault.StaticValue(types.ListValueMust(types.NumberType, []attr.Value{\n" +
+					"types.NumberValue(big.NewFloat(1)),\n" +
+					"types.NumberValue(big.NewFloat(2.5)),\n" +
+					"}))",
+			},
+		},
+		"object": {
+			elementType: schema.ElementType{
+				Object: &schema.ObjectType{
+					AttributeTypes: []schema.ObjectAttributeType{
+						{
+							Name: "enabled",
+							Bool: &schema.BoolType{},
+						},
+						{
+							Name:  "max_count",
+							Int64: &schema.Int64Type{},
+						},
+						{
+							Name: "tags",
+							List: &schema.ListType{
+								ElementType: schema.ElementType{
+									String: &schema.StringType{},
+								},
+							},
+						},
+					},
+				},
+			},
+			value: []any{
+				map[string]any{
+					"enabled":  true,
+					"maxCount": 3,
+				},
+			},
+			expected: &schema.CustomDefault{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/types",
+					},
+				},
+				SchemaDefinition: "listdefault.StaticValue(types.ListValueMust(types.ObjectType{AttrTypes: map[string]attr.Type{\n" +
+					"\"enabled\": types.BoolType,\n" +
+					"\"max_count\": types.Int64Type,\n" +
+					"\"tags\": types.ListType{ElemType: types.StringType},\n" +
+					"}}, []attr.Value{\n" +
+					"types.ObjectValueMust(map[string]attr.Type{\n" +
+					"\"enabled\": types.BoolType,\n" +
+					"\"max_count\": types.Int64Type,\n" +
+					"\"tags\": types.ListType{ElemType: types.StringType},\n" +
+					"}, map[string]attr.Value{\n" +
+					"\"enabled\": types.BoolValue(true),\n" +
+					"\"max_count\": types.Int64Value(3),\n" +
+					"\"tags\": types.ListNull(types.StringType),\n" +
+					"}),\n" +
+					"}))",
+			},
+		},
+		"invalid element": {
+			elementType: schema.ElementType{
+				Int64: &schema.Int64Type{},
+			},
+			value:         []any{1, 1.5},
+			expectedError: "element 1: expected an integer, got: 1.5",
+		},
+		"invalid value": {
+			elementType: schema.ElementType{
+				String: &schema.StringType{},
+			},
+			value:         "one",
+			expectedError: "expected an array, got: one",
+		},
+		"unknown object property": {
+			elementType: schema.ElementType{
+				Object: &schema.ObjectType{
+					AttributeTypes: []schema.ObjectAttributeType{
+						{
+							Name: "enabled",
+							Bool: &schema.BoolType{},
+						},
+					},
+				},
+			},
+			value: []any{
+				map[string]any{
+					"disabled": true,
+				},
+			},
+			expectedError: "element 0: property 'disabled' doesn't match any attribute",
+		},
+		"custom type": {
+			elementType: schema.ElementType{
+				String: &schema.StringType{
+					CustomType: &schema.CustomType{
+						Type:      "timetypes.RFC3339Type{}",
+						ValueType: "timetypes.RFC3339",
+					},
+				},
+			},
+			value:         []any{"2024-01-01T00:00:00Z"},
+			expectedError: "values of custom types are not supported",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := frameworkdefaults.ListDefaultStaticValue(testCase.elementType, testCase.value)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// MapDefaultPackage is the name of the map default package in the
+	// framework.
+	MapDefaultPackage = "mapdefault"
+)
+
+var (
+	// MapDefaultCodeImport is a single allocation of the framework
+	// mapdefault package import.
+	MapDefaultCodeImport code.Import = CodeImport("resource/schema/" + MapDefaultPackage)
+)
+
+// MapDefaultStaticValue returns a custom default mapped to the mapdefault
+// package StaticValue function, with a map value of the given element type.
+// An error is returned if the value doesn't match the element type.
+func MapDefaultStaticValue(elementType schema.ElementType, value any) (*schema.CustomDefault, error) {
+	return staticDefault(MapDefaultCodeImport, MapDefaultPackage, schema.ElementType{
+		Map: &schema.MapType{
+			ElementType: elementType,
+		},
+	}, value)
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
+)
+
+func TestMapDefaultStaticValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   schema.ElementType
+		value         any
+		expected      *schema.CustomDefault
+		expectedError string
+	}{
+		"string": {
+			elementType: schema.ElementType{
+				String: &schema.StringType{},
+			},
+			value: map[string]any{
+				"team": "platform",
+				"env":  nil,
+			},
+			expected: &schema.CustomDefault{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/types",
+					},
+				},
+				SchemaDefinition: "mapdefault.StaticValue(types.MapValueMust(types.StringType, map[string]attr.Value{\n" +
+					"\"env\": types.StringNull(),\n" +
+					"\"team\": types.StringValue(\"platform\"),\n" +
+					"}))",
+			},
+		},
+		"list": {
+			elementType: schema.ElementType{
+				List: &schema.ListType{
+					ElementType: schema.ElementType{
+						Int64: &schema.Int64Type{},
+					},
+				},
+			},
+			value: map[string]any{
+				"ports": []any{80, 443},
+			},
+			expected: &schema.CustomDefault{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/mapdefault",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/types",
+					},
+				},
+				SchemaDefinition: "mapdefault.StaticValue(types.MapValueMust(types.ListType{ElemType: types.Int64Type}, map[string]attr.Value{\n" +
+					"\"ports\": types.ListValueMust(types.Int64Type, []attr.Value{\n" +
+					"types.Int64Value(80),\n" +
+					"types.Int64Value(443),\n" +
+					"}),\n" +
+					"}))",
+			},
+		},
+		"invalid value": {
+			elementType: schema.ElementType{
+				String: &schema.StringType{},
+			},
+			value:         []any{"one"},
+			expectedError: "expected an object, got: [one]",
+		},
+		"invalid element": {
+			elementType: schema.ElementType{
+				String: &schema.StringType{},
+			},
+			value: map[string]any{
+				"count": 1,
+			},
+			expectedError: "key 'count': expected a string, got: 1",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := frameworkdefaults.MapDefaultStaticValue(testCase.elementType, testCase.value)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
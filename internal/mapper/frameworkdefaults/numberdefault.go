@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// NumberDefaultPackage is the name of the number default package in the
+	// framework.
+	NumberDefaultPackage = "numberdefault"
+)
+
+var (
+	// NumberDefaultCodeImport is a single allocation of the framework
+	// numberdefault package import.
+	NumberDefaultCodeImport code.Import = CodeImport("resource/schema/" + NumberDefaultPackage)
+)
+
+// NumberDefaultStaticBigFloat returns a custom default mapped to the
+// numberdefault package StaticBigFloat function.
+func NumberDefaultStaticBigFloat(value float64) *schema.CustomDefault {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(NumberDefaultPackage)
+	schemaDefinition.WriteString(".StaticBigFloat(big.NewFloat(")
+	schemaDefinition.WriteString(strconv.FormatFloat(value, 'f', -1, 64))
+	schemaDefinition.WriteString("))")
+
+	return &schema.CustomDefault{
+		Imports: []code.Import{
+			BigCodeImport,
+			NumberDefaultCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
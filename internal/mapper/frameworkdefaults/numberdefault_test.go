@@ -0,0 +1,51 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
+)
+
+func TestNumberDefaultStaticBigFloat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value    float64
+		expected *schema.CustomDefault
+	}{
+		"test": {
+			value: 1.5,
+			expected: &schema.CustomDefault{
+				Imports: []code.Import{
+					{
+						Path: "math/big",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/numberdefault",
+					},
+				},
+				SchemaDefinition: "numberdefault.StaticBigFloat(big.NewFloat(1.5))",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkdefaults.NumberDefaultStaticBigFloat(testCase.value)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// ObjectDefaultPackage is the name of the object default package in the
+	// framework.
+	ObjectDefaultPackage = "objectdefault"
+)
+
+var (
+	// ObjectDefaultCodeImport is a single allocation of the framework
+	// objectdefault package import.
+	ObjectDefaultCodeImport code.Import = CodeImport("resource/schema/" + ObjectDefaultPackage)
+)
+
+// ObjectDefaultStaticValue returns a custom default mapped to the
+// objectdefault package StaticValue function, with an object value of the
+// given attribute types. Attributes missing from the value are null. An error
+// is returned if the value doesn't match the attribute types.
+func ObjectDefaultStaticValue(attributeTypes []schema.ObjectAttributeType, value any) (*schema.CustomDefault, error) {
+	return staticDefault(ObjectDefaultCodeImport, ObjectDefaultPackage, schema.ElementType{
+		Object: &schema.ObjectType{
+			AttributeTypes: attributeTypes,
+		},
+	}, value)
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
+)
+
+func TestObjectDefaultStaticValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeTypes []schema.ObjectAttributeType
+		value          any
+		expected       *schema.CustomDefault
+		expectedError  string
+	}{
+		"nested object": {
+			attributeTypes: []schema.ObjectAttributeType{
+				{
+					Name:   "name",
+					String: &schema.StringType{},
+				},
+				{
+					Name: "retry_policy",
+					Object: &schema.ObjectType{
+						AttributeTypes: []schema.ObjectAttributeType{
+							{
+								Name:    "backoff",
+								Float64: &schema.Float64Type{},
+							},
+						},
+					},
+				},
+				{
+					Name: "spec",
+					Object: &schema.ObjectType{
+						AttributeTypes: []schema.ObjectAttributeType{
+							{
+								Name:  "replicas",
+								Int64: &schema.Int64Type{},
+							},
+						},
+					},
+				},
+			},
+			value: map[string]any{
+				"name": "default",
+				"retryPolicy": map[string]any{
+					"backoff": 1.5,
+				},
+			},
+			expected: &schema.CustomDefault{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/types",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+					},
+				},
+				SchemaDefinition: "objectdefault.StaticValue(types.ObjectValueMust(map[string]attr.Type{\n" +
+					"\"name\": types.StringType,\n" +
+					"\"retry_policy\": types.ObjectType{AttrTypes: map[string]attr.Type{\n" +
+					"\"backoff\": types.Float64Type,\n" +
+					"}},\n" +
+					"\"spec\": types.ObjectType{AttrTypes: map[string]attr.Type{\n" +
+					"\"replicas\": types.Int64Type,\n" +
+					"}},\n" +
+					"}, map[string]attr.Value{\n" +
+					"\"name\": types.StringValue(\"default\"),\n" +
+					"\"retry_policy\": types.ObjectValueMust(map[string]attr.Type{\n" +
+					"\"backoff\": types.Float64Type,\n" +
+					"}, map[string]attr.Value{\n" +
+					"\"backoff\": types.Float64Value(1.5),\n" +
+					"}),\n" +
+					"\"spec\": types.ObjectNull(map[string]attr.Type{\n" +
+					"\"replicas\": types.Int64Type,\n" +
+					"}),\n" +
+					"}))",
+			},
+		},
+		"invalid attribute": {
+			attributeTypes: []schema.ObjectAttributeType{
+				{
+					Name:  "replicas",
+					Int64: &schema.Int64Type{},
+				},
+			},
+			value: map[string]any{
+				"replicas": "three",
+			},
+			expectedError: "attribute 'replicas': expected an integer, got: three",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := frameworkdefaults.ObjectDefaultStaticValue(testCase.attributeTypes, testCase.value)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
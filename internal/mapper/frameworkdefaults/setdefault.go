@@ -0,0 +1,32 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// SetDefaultPackage is the name of the set default package in the
+	// framework.
+	SetDefaultPackage = "setdefault"
+)
+
+var (
+	// SetDefaultCodeImport is a single allocation of the framework
+	// setdefault package import.
+	SetDefaultCodeImport code.Import = CodeImport("resource/schema/" + SetDefaultPackage)
+)
+
+// SetDefaultStaticValue returns a custom default mapped to the setdefault
+// package StaticValue function, with a set value of the given element type.
+// An error is returned if the value doesn't match the element type.
+func SetDefaultStaticValue(elementType schema.ElementType, value any) (*schema.CustomDefault, error) {
+	return staticDefault(SetDefaultCodeImport, SetDefaultPackage, schema.ElementType{
+		Set: &schema.SetType{
+			ElementType: elementType,
+		},
+	}, value)
+}
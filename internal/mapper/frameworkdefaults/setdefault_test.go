@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
+)
+
+func TestSetDefaultStaticValue(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		elementType   schema.ElementType
+		value         any
+		expected      *schema.CustomDefault
+		expectedError string
+	}{
+		"float64": {
+			elementType: schema.ElementType{
+				Float64: &schema.Float64Type{},
+			},
+			value: []any{1, 2.5},
+			expected: &schema.CustomDefault{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/attr",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/types",
+					},
+				},
+				SchemaDefinition: "setdefault.StaticValue(types.SetValueMust(types.Float64Type, []attr.Value{\n" +
+					"types.Float64Value(1),\n" +
+					"types.Float64Value(2.5),\n" +
+					"}))",
+			},
+		},
+		"invalid element": {
+			elementType: schema.ElementType{
+				Bool: &schema.BoolType{},
+			},
+			value:         []any{"true"},
+			expectedError: "element 0: expected a boolean, got: true",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := frameworkdefaults.SetDefaultStaticValue(testCase.elementType, testCase.value)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,379 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkdefaults
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// errCustomType is returned for values of custom types, as only the schema
+// definition of the custom type, not of its values, is known.
+var errCustomType = errors.New("values of custom types are not supported")
+
+// staticValue writes the schema definition of a framework value, such as
+// types.ListValueMust(...), from a value decoded from the OpenAPI spec, and
+// collects the code imports it needs.
+type staticValue struct {
+	imports          []code.Import
+	schemaDefinition strings.Builder
+}
+
+// staticDefault returns a custom default mapped to the StaticValue function
+// of the given framework defaults package, with the value of the given type.
+func staticDefault(packageImport code.Import, packageName string, elementType schema.ElementType, value any) (*schema.CustomDefault, error) {
+	v := &staticValue{
+		imports: []code.Import{
+			packageImport,
+		},
+	}
+
+	v.schemaDefinition.WriteString(packageName)
+	v.schemaDefinition.WriteString(".StaticValue(")
+
+	err := v.writeValue(elementType, value)
+	if err != nil {
+		return nil, err
+	}
+
+	v.schemaDefinition.WriteString(")")
+
+	return &schema.CustomDefault{
+		Imports:          v.imports,
+		SchemaDefinition: v.schemaDefinition.String(),
+	}, nil
+}
+
+func (v *staticValue) addImport(codeImport code.Import) {
+	if !slices.Contains(v.imports, codeImport) {
+		v.imports = append(v.imports, codeImport)
+	}
+}
+
+func (v *staticValue) writeType(elementType schema.ElementType) error {
+	if customType(elementType) != nil {
+		return errCustomType
+	}
+
+	v.addImport(TypesCodeImport)
+
+	switch {
+	case elementType.Bool != nil:
+		v.schemaDefinition.WriteString("types.BoolType")
+	case elementType.Float64 != nil:
+		v.schemaDefinition.WriteString("types.Float64Type")
+	case elementType.Int64 != nil:
+		v.schemaDefinition.WriteString("types.Int64Type")
+	case elementType.Number != nil:
+		v.schemaDefinition.WriteString("types.NumberType")
+	case elementType.String != nil:
+		v.schemaDefinition.WriteString("types.StringType")
+	case elementType.List != nil:
+		return v.writeCollectionType("types.ListType", elementType.List.ElementType)
+	case elementType.Map != nil:
+		return v.writeCollectionType("types.MapType", elementType.Map.ElementType)
+	case elementType.Set != nil:
+		return v.writeCollectionType("types.SetType", elementType.Set.ElementType)
+	case elementType.Object != nil:
+		v.schemaDefinition.WriteString("types.ObjectType{AttrTypes: ")
+		if err := v.writeAttributeTypes(elementType.Object.AttributeTypes); err != nil {
+			return err
+		}
+		v.schemaDefinition.WriteString("}")
+	default:
+		return errors.New("unsupported element type")
+	}
+
+	return nil
+}
+
+func (v *staticValue) writeCollectionType(collectionType string, elementType schema.ElementType) error {
+	v.schemaDefinition.WriteString(collectionType + "{ElemType: ")
+	if err := v.writeType(elementType); err != nil {
+		return err
+	}
+	v.schemaDefinition.WriteString("}")
+
+	return nil
+}
+
+func (v *staticValue) writeAttributeTypes(attributeTypes []schema.ObjectAttributeType) error {
+	v.addImport(AttrCodeImport)
+	v.schemaDefinition.WriteString("map[string]attr.Type{\n")
+
+	for _, attributeType := range attributeTypes {
+		v.schemaDefinition.WriteString(strconv.Quote(attributeType.Name) + ": ")
+		if err := v.writeType(util.CreateElementType(attributeType)); err != nil {
+			return fmt.Errorf("attribute '%s': %w", attributeType.Name, err)
+		}
+		v.schemaDefinition.WriteString(",\n")
+	}
+
+	v.schemaDefinition.WriteString("}")
+
+	return nil
+}
+
+func (v *staticValue) writeValue(elementType schema.ElementType, value any) error {
+	if customType(elementType) != nil {
+		return errCustomType
+	}
+
+	if value == nil {
+		return v.writeNull(elementType)
+	}
+
+	v.addImport(TypesCodeImport)
+
+	switch {
+	case elementType.Bool != nil:
+		boolValue, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("expected a boolean, got: %v", value)
+		}
+		v.schemaDefinition.WriteString("types.BoolValue(" + strconv.FormatBool(boolValue) + ")")
+	case elementType.Float64 != nil:
+		floatValue, ok := toFloat64(value)
+		if !ok {
+			return fmt.Errorf("expected a number, got: %v", value)
+		}
+		v.schemaDefinition.WriteString("types.Float64Value(" + strconv.FormatFloat(floatValue, 'f', -1, 64) + ")")
+	case elementType.Int64 != nil:
+		intValue, ok := toInt64(value)
+		if !ok {
+			return fmt.Errorf("expected an integer, got: %v", value)
+		}
+		v.schemaDefinition.WriteString("types.Int64Value(" + strconv.FormatInt(intValue, 10) + ")")
+	case elementType.Number != nil:
+		floatValue, ok := toFloat64(value)
+		if !ok {
+			return fmt.Errorf("expected a number, got: %v", value)
+		}
+		v.addImport(BigCodeImport)
+		v.schemaDefinition.WriteString("types.NumberValue(big.NewFloat(" + strconv.FormatFloat(floatValue, 'f', -1, 64) + "))")
+	case elementType.String != nil:
+		stringValue, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("expected a string, got: %v", value)
+		}
+		v.schemaDefinition.WriteString("types.StringValue(" + strconv.Quote(stringValue) + ")")
+	case elementType.List != nil:
+		return v.writeCollectionValue("types.ListValueMust", elementType.List.ElementType, value)
+	case elementType.Set != nil:
+		return v.writeCollectionValue("types.SetValueMust", elementType.Set.ElementType, value)
+	case elementType.Map != nil:
+		return v.writeMapValue(elementType.Map.ElementType, value)
+	case elementType.Object != nil:
+		return v.writeObjectValue(elementType.Object.AttributeTypes, value)
+	default:
+		return errors.New("unsupported element type")
+	}
+
+	return nil
+}
+
+func (v *staticValue) writeNull(elementType schema.ElementType) error {
+	v.addImport(TypesCodeImport)
+
+	switch {
+	case elementType.Bool != nil:
+		v.schemaDefinition.WriteString("types.BoolNull()")
+	case elementType.Float64 != nil:
+		v.schemaDefinition.WriteString("types.Float64Null()")
+	case elementType.Int64 != nil:
+		v.schemaDefinition.WriteString("types.Int64Null()")
+	case elementType.Number != nil:
+		v.schemaDefinition.WriteString("types.NumberNull()")
+	case elementType.String != nil:
+		v.schemaDefinition.WriteString("types.StringNull()")
+	case elementType.List != nil:
+		return v.writeCollectionNull("types.ListNull", elementType.List.ElementType)
+	case elementType.Map != nil:
+		return v.writeCollectionNull("types.MapNull", elementType.Map.ElementType)
+	case elementType.Set != nil:
+		return v.writeCollectionNull("types.SetNull", elementType.Set.ElementType)
+	case elementType.Object != nil:
+		v.schemaDefinition.WriteString("types.ObjectNull(")
+		if err := v.writeAttributeTypes(elementType.Object.AttributeTypes); err != nil {
+			return err
+		}
+		v.schemaDefinition.WriteString(")")
+	default:
+		return errors.New("unsupported element type")
+	}
+
+	return nil
+}
+
+func (v *staticValue) writeCollectionNull(function string, elementType schema.ElementType) error {
+	v.schemaDefinition.WriteString(function + "(")
+	if err := v.writeType(elementType); err != nil {
+		return err
+	}
+	v.schemaDefinition.WriteString(")")
+
+	return nil
+}
+
+func (v *staticValue) writeCollectionValue(function string, elementType schema.ElementType, value any) error {
+	elements, ok := value.([]any)
+	if !ok {
+		return fmt.Errorf("expected an array, got: %v", value)
+	}
+
+	v.addImport(AttrCodeImport)
+	v.schemaDefinition.WriteString(function + "(")
+	if err := v.writeType(elementType); err != nil {
+		return err
+	}
+	v.schemaDefinition.WriteString(", []attr.Value{\n")
+
+	for i, element := range elements {
+		if err := v.writeValue(elementType, element); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+		v.schemaDefinition.WriteString(",\n")
+	}
+
+	v.schemaDefinition.WriteString("})")
+
+	return nil
+}
+
+func (v *staticValue) writeMapValue(elementType schema.ElementType, value any) error {
+	elements, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("expected an object, got: %v", value)
+	}
+
+	v.addImport(AttrCodeImport)
+	v.schemaDefinition.WriteString("types.MapValueMust(")
+	if err := v.writeType(elementType); err != nil {
+		return err
+	}
+	v.schemaDefinition.WriteString(", map[string]attr.Value{\n")
+
+	for _, key := range util.SortedKeys(elements) {
+		v.schemaDefinition.WriteString(strconv.Quote(key) + ": ")
+		if err := v.writeValue(elementType, elements[key]); err != nil {
+			return fmt.Errorf("key '%s': %w", key, err)
+		}
+		v.schemaDefinition.WriteString(",\n")
+	}
+
+	v.schemaDefinition.WriteString("})")
+
+	return nil
+}
+
+// writeObjectValue writes an object value with every attribute type, where attributes missing from the value are null. The
+// properties of the value are matched to attribute types by name, or by the Terraform identifier of the name, as attribute
+// names of nested attributes are converted to Terraform identifiers.
+func (v *staticValue) writeObjectValue(attributeTypes []schema.ObjectAttributeType, value any) error {
+	properties, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("expected an object, got: %v", value)
+	}
+
+	attributeValues := make(map[string]any, len(properties))
+	for _, key := range util.SortedKeys(properties) {
+		i := slices.IndexFunc(attributeTypes, func(attributeType schema.ObjectAttributeType) bool {
+			return attributeType.Name == key || attributeType.Name == util.TerraformIdentifier(key)
+		})
+		if i == -1 {
+			return fmt.Errorf("property '%s' doesn't match any attribute", key)
+		}
+
+		attributeValues[attributeTypes[i].Name] = properties[key]
+	}
+
+	v.schemaDefinition.WriteString("types.ObjectValueMust(")
+	if err := v.writeAttributeTypes(attributeTypes); err != nil {
+		return err
+	}
+	v.schemaDefinition.WriteString(", map[string]attr.Value{\n")
+
+	for _, attributeType := range attributeTypes {
+		v.schemaDefinition.WriteString(strconv.Quote(attributeType.Name) + ": ")
+		if err := v.writeValue(util.CreateElementType(attributeType), attributeValues[attributeType.Name]); err != nil {
+			return fmt.Errorf("attribute '%s': %w", attributeType.Name, err)
+		}
+		v.schemaDefinition.WriteString(",\n")
+	}
+
+	v.schemaDefinition.WriteString("})")
+
+	return nil
+}
+
+func customType(elementType schema.ElementType) *schema.CustomType {
+	switch {
+	case elementType.Bool != nil:
+		return elementType.Bool.CustomType
+	case elementType.Float64 != nil:
+		return elementType.Float64.CustomType
+	case elementType.Int64 != nil:
+		return elementType.Int64.CustomType
+	case elementType.Number != nil:
+		return elementType.Number.CustomType
+	case elementType.String != nil:
+		return elementType.String.CustomType
+	case elementType.List != nil:
+		return elementType.List.CustomType
+	case elementType.Map != nil:
+		return elementType.Map.CustomType
+	case elementType.Set != nil:
+		return elementType.Set.CustomType
+	case elementType.Object != nil:
+		return elementType.Object.CustomType
+	}
+
+	return nil
+}
+
+// toFloat64 converts a number decoded from YAML or JSON to a float64.
+func toFloat64(value any) (float64, bool) {
+	switch number := value.(type) {
+	case int:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	case uint64:
+		return float64(number), true
+	case float64:
+		return number, true
+	}
+
+	return 0, false
+}
+
+// toInt64 converts a whole number decoded from YAML or JSON to an int64.
+func toInt64(value any) (int64, bool) {
+	switch number := value.(type) {
+	case int:
+		return int64(number), true
+	case int64:
+		return number, true
+	case uint64:
+		if number > math.MaxInt64 {
+			return 0, false
+		}
+		return int64(number), true
+	case float64:
+		if number != math.Trunc(number) || number < math.MinInt64 || number >= math.MaxInt64 {
+			return 0, false
+		}
+		return int64(number), true
+	}
+
+	return 0, false
+}
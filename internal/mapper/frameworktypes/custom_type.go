@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworktypes
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// TimeTypesCodeImportPath is the code import path for the timetypes package in the framework timetypes module.
+	TimeTypesCodeImportPath = "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+
+	// IPTypesCodeImportPath is the code import path for the iptypes package in the framework nettypes module.
+	IPTypesCodeImportPath = "github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"
+
+	// CIDRTypesCodeImportPath is the code import path for the cidrtypes package in the framework nettypes module.
+	CIDRTypesCodeImportPath = "github.com/hashicorp/terraform-plugin-framework-nettypes/cidrtypes"
+
+	// JSONTypesCodeImportPath is the code import path for the jsontypes package in the framework jsontypes module.
+	JSONTypesCodeImportPath = "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+)
+
+// TimeTypesRFC3339 returns a custom type mapped to the timetypes package RFC3339 type.
+func TimeTypesRFC3339() *schema.CustomType {
+	return newCustomType(TimeTypesCodeImportPath, "timetypes.RFC3339Type{}", "timetypes.RFC3339")
+}
+
+// IPTypesIPv4Address returns a custom type mapped to the iptypes package IPv4Address type.
+func IPTypesIPv4Address() *schema.CustomType {
+	return newCustomType(IPTypesCodeImportPath, "iptypes.IPv4AddressType{}", "iptypes.IPv4Address")
+}
+
+// IPTypesIPv6Address returns a custom type mapped to the iptypes package IPv6Address type.
+func IPTypesIPv6Address() *schema.CustomType {
+	return newCustomType(IPTypesCodeImportPath, "iptypes.IPv6AddressType{}", "iptypes.IPv6Address")
+}
+
+// CIDRTypesIPv4Prefix returns a custom type mapped to the cidrtypes package IPv4Prefix type.
+func CIDRTypesIPv4Prefix() *schema.CustomType {
+	return newCustomType(CIDRTypesCodeImportPath, "cidrtypes.IPv4PrefixType{}", "cidrtypes.IPv4Prefix")
+}
+
+// CIDRTypesIPv6Prefix returns a custom type mapped to the cidrtypes package IPv6Prefix type.
+func CIDRTypesIPv6Prefix() *schema.CustomType {
+	return newCustomType(CIDRTypesCodeImportPath, "cidrtypes.IPv6PrefixType{}", "cidrtypes.IPv6Prefix")
+}
+
+// JSONTypesNormalized returns a custom type mapped to the jsontypes package Normalized type.
+func JSONTypesNormalized() *schema.CustomType {
+	return newCustomType(JSONTypesCodeImportPath, "jsontypes.NormalizedType{}", "jsontypes.Normalized")
+}
+
+// DefaultFormatCustomTypes returns the default custom type for each string format that has a framework custom type. Formats
+// without a framework custom type, like `date`, `uuid`, or `cidr` (which can be IPv4 or IPv6), are mapped to plain strings unless
+// configured in the generator config.
+func DefaultFormatCustomTypes() map[string]*schema.CustomType {
+	return map[string]*schema.CustomType{
+		util.OAS_format_date_time: TimeTypesRFC3339(),
+		util.OAS_format_ipv4:      IPTypesIPv4Address(),
+		util.OAS_format_ipv6:      IPTypesIPv6Address(),
+		util.OAS_format_ipv4_cidr: CIDRTypesIPv4Prefix(),
+		util.OAS_format_ipv6_cidr: CIDRTypesIPv6Prefix(),
+		util.OAS_format_json:      JSONTypesNormalized(),
+	}
+}
+
+func newCustomType(importPath string, customType string, valueType string) *schema.CustomType {
+	return &schema.CustomType{
+		Import: &code.Import{
+			Path: importPath,
+		},
+		Type:      customType,
+		ValueType: valueType,
+	}
+}
@@ -0,0 +1,12 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+// Package frameworktypes contains functionality for mapping OpenAPI formats
+// onto specification custom types that use the terraform-plugin-framework
+// custom type modules, such as terraform-plugin-framework-timetypes.
+//
+// Custom types handle semantic equality for values with multiple valid string
+// representations, like RFC 3339 timestamps with different time zone offsets,
+// which would otherwise cause differences between the configuration and the
+// value returned by the API.
+package frameworktypes
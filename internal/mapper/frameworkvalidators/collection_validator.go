@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// convertibleCollectionFunctions are the functions with the same name and
+// arguments in the listvalidator and setvalidator packages. Functions that are
+// called with validators of their own package, like All, aren't converted.
+var convertibleCollectionFunctions = []string{
+	"AlsoRequires",
+	"AtLeastOneOf",
+	"ConflictsWith",
+	"ExactlyOneOf",
+	"IsRequired",
+	"NoNullValues",
+	"SizeAtLeast",
+	"SizeAtMost",
+	"SizeBetween",
+	"ValueFloat32sAre",
+	"ValueFloat64sAre",
+	"ValueInt32sAre",
+	"ValueInt64sAre",
+	"ValueListsAre",
+	"ValueMapsAre",
+	"ValueNumbersAre",
+	"ValueSetsAre",
+	"ValueStringsAre",
+}
+
+// convertCollectionValidator returns a copy of a custom validator mapped to a
+// function of one collection validation package, such as listvalidator, mapped
+// to the function of the same name in another collection validation package,
+// such as setvalidator. An error is returned for validators of other packages,
+// which are typed for the original collection, and for functions without an
+// equivalent.
+func convertCollectionValidator(validator *schema.CustomValidator, fromImport code.Import, fromPackage string, toImport code.Import, toPackage string) (*schema.CustomValidator, error) {
+	if validator == nil {
+		return nil, nil
+	}
+
+	function, ok := strings.CutPrefix(validator.SchemaDefinition, fromPackage+".")
+	functionName, _, _ := strings.Cut(function, "(")
+
+	if !ok || !slices.Contains(convertibleCollectionFunctions, functionName) {
+		return nil, fmt.Errorf("validator '%s' has no %s package equivalent", validator.SchemaDefinition, toPackage)
+	}
+
+	imports := make([]code.Import, 0, len(validator.Imports))
+
+	for _, validatorImport := range validator.Imports {
+		if validatorImport == fromImport {
+			validatorImport = toImport
+		}
+
+		if !slices.Contains(imports, validatorImport) {
+			imports = append(imports, validatorImport)
+		}
+	}
+
+	return &schema.CustomValidator{
+		Imports:          imports,
+		SchemaDefinition: toPackage + "." + function,
+	}, nil
+}
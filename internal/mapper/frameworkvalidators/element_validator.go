@@ -0,0 +1,48 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// elementValidator returns a custom validator mapped to a framework
+// validators function that applies the given validators to each element or
+// key of a collection, such as the listvalidator package ValueStringsAre
+// function. If the validators are nil or empty, nil is returned.
+func elementValidator(packageImport code.Import, packageName string, functionName string, validators []*schema.CustomValidator) *schema.CustomValidator {
+	if len(validators) == 0 {
+		return nil
+	}
+
+	imports := []code.Import{
+		packageImport,
+	}
+
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(packageName)
+	schemaDefinition.WriteString("." + functionName + "(\n")
+
+	for _, validator := range validators {
+		for _, validatorImport := range validator.Imports {
+			if !slices.Contains(imports, validatorImport) {
+				imports = append(imports, validatorImport)
+			}
+		}
+
+		schemaDefinition.WriteString(validator.SchemaDefinition + ",\n")
+	}
+
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports:          imports,
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
@@ -23,6 +23,89 @@ var (
 	Float64ValidatorCodeImport code.Import = CodeImport(Float64ValidatorPackage)
 )
 
+// Float64ValidatorAtLeast returns a custom validator mapped to the
+// float64validator package AtLeast function.
+func Float64ValidatorAtLeast(minimum float64) *schema.CustomValidator {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(Float64ValidatorPackage)
+	schemaDefinition.WriteString(".AtLeast(")
+	schemaDefinition.WriteString(strconv.FormatFloat(minimum, 'f', -1, 64))
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			Float64ValidatorCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
+
+// Float64ValidatorAtMost returns a custom validator mapped to the
+// float64validator package AtMost function.
+func Float64ValidatorAtMost(maximum float64) *schema.CustomValidator {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(Float64ValidatorPackage)
+	schemaDefinition.WriteString(".AtMost(")
+	schemaDefinition.WriteString(strconv.FormatFloat(maximum, 'f', -1, 64))
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			Float64ValidatorCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
+
+// Float64ValidatorBetween returns a custom validator mapped to the
+// float64validator package Between function.
+func Float64ValidatorBetween(minimum, maximum float64) *schema.CustomValidator {
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(Float64ValidatorPackage)
+	schemaDefinition.WriteString(".Between(")
+	schemaDefinition.WriteString(strconv.FormatFloat(minimum, 'f', -1, 64))
+	schemaDefinition.WriteString(", ")
+	schemaDefinition.WriteString(strconv.FormatFloat(maximum, 'f', -1, 64))
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			Float64ValidatorCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
+
+// Float64ValidatorNoneOf returns a custom validator mapped to the
+// float64validator package NoneOf function. If the values are nil or empty,
+// nil is returned.
+func Float64ValidatorNoneOf(values []float64) *schema.CustomValidator {
+	if len(values) == 0 {
+		return nil
+	}
+
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(Float64ValidatorPackage)
+	schemaDefinition.WriteString(".NoneOf(\n")
+
+	for _, value := range values {
+		schemaDefinition.WriteString(strconv.FormatFloat(value, 'f', -1, 64) + ",\n")
+	}
+
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			Float64ValidatorCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
+
 // Float64ValidatorOneOf returns a custom validator mapped to the Float64validator
 // package OneOf function. If the values are nil or empty, nil is returned.
 func Float64ValidatorOneOf(values []float64) *schema.CustomValidator {
@@ -94,3 +94,39 @@ func ListValidatorUniqueValues() *schema.CustomValidator {
 		SchemaDefinition: schemaDefinition.String(),
 	}
 }
+
+// ListValidatorValueStringsAre returns a custom validator mapped to the listvalidator
+// package ValueStringsAre function, which applies the given string validators to
+// each element. If the validators are nil or empty, nil is returned.
+func ListValidatorValueStringsAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(ListValidatorCodeImport, ListValidatorPackage, "ValueStringsAre", validators)
+}
+
+// ListValidatorValueInt64sAre returns a custom validator mapped to the listvalidator
+// package ValueInt64sAre function, which applies the given int64 validators to
+// each element. If the validators are nil or empty, nil is returned.
+func ListValidatorValueInt64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(ListValidatorCodeImport, ListValidatorPackage, "ValueInt64sAre", validators)
+}
+
+// ListValidatorValueFloat64sAre returns a custom validator mapped to the listvalidator
+// package ValueFloat64sAre function, which applies the given float64 validators to
+// each element. If the validators are nil or empty, nil is returned.
+func ListValidatorValueFloat64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(ListValidatorCodeImport, ListValidatorPackage, "ValueFloat64sAre", validators)
+}
+
+// ListValidatorValueNumbersAre returns a custom validator mapped to the listvalidator
+// package ValueNumbersAre function, which applies the given number validators to
+// each element. If the validators are nil or empty, nil is returned.
+func ListValidatorValueNumbersAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(ListValidatorCodeImport, ListValidatorPackage, "ValueNumbersAre", validators)
+}
+
+// ListValidatorFromSetValidator returns the listvalidator package equivalent
+// of a custom validator mapped to a setvalidator package function, for sets
+// that are converted to lists. An error is returned for validators that can't
+// be converted, such as validators of other packages.
+func ListValidatorFromSetValidator(validator *schema.CustomValidator) (*schema.CustomValidator, error) {
+	return convertCollectionValidator(validator, SetValidatorCodeImport, SetValidatorPackage, ListValidatorCodeImport, ListValidatorPackage)
+}
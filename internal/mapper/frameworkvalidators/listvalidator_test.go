@@ -148,3 +148,184 @@ func TestListValidatorUniqueValues(t *testing.T) {
 		})
 	}
 }
+
+func TestListValidatorElementValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		function   func([]*schema.CustomValidator) *schema.CustomValidator
+		validators []*schema.CustomValidator
+		expected   *schema.CustomValidator
+	}{
+		"nil": {
+			function:   frameworkvalidators.ListValidatorValueStringsAre,
+			validators: nil,
+			expected:   nil,
+		},
+		"multiple": {
+			function: frameworkvalidators.ListValidatorValueStringsAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.StringValidatorLengthAtLeast(1),
+				frameworkvalidators.StringValidatorRegexMatches("^[a-z]+$", ""),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+					{
+						Path: "regexp",
+					},
+				},
+				SchemaDefinition: "listvalidator.ValueStringsAre(\nstringvalidator.LengthAtLeast(1),\nstringvalidator.RegexMatches(regexp.MustCompile(\"^[a-z]+$\"), \"\"),\n)",
+			},
+		},
+		"ValueStringsAre": {
+			function: frameworkvalidators.ListValidatorValueStringsAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.StringValidatorLengthAtMost(10),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+				},
+				SchemaDefinition: "listvalidator.ValueStringsAre(\nstringvalidator.LengthAtMost(10),\n)",
+			},
+		},
+		"ValueInt64sAre": {
+			function: frameworkvalidators.ListValidatorValueInt64sAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.Int64ValidatorAtLeast(1),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+					},
+				},
+				SchemaDefinition: "listvalidator.ValueInt64sAre(\nint64validator.AtLeast(1),\n)",
+			},
+		},
+		"ValueFloat64sAre": {
+			function: frameworkvalidators.ListValidatorValueFloat64sAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.Float64ValidatorAtMost(1.5),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+					},
+				},
+				SchemaDefinition: "listvalidator.ValueFloat64sAre(\nfloat64validator.AtMost(1.5),\n)",
+			},
+		},
+		"ValueNumbersAre": {
+			function: frameworkvalidators.ListValidatorValueNumbersAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.NumberValidatorOneOf([]float64{1.5}),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+					},
+					{
+						Path: "math/big",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator",
+					},
+				},
+				SchemaDefinition: "listvalidator.ValueNumbersAre(\nnumbervalidator.OneOf(\nbig.NewFloat(1.5),\n),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.function(testCase.validators)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestListValidatorFromSetValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     *schema.CustomValidator
+		expected      *schema.CustomValidator
+		expectedError string
+	}{
+		"nil": {
+			validator: nil,
+			expected:  nil,
+		},
+		"SizeAtLeast": {
+			validator: frameworkvalidators.SetValidatorSizeAtLeast(1),
+			expected:  frameworkvalidators.ListValidatorSizeAtLeast(1),
+		},
+		"ValueInt64sAre": {
+			validator: frameworkvalidators.SetValidatorValueInt64sAre([]*schema.CustomValidator{
+				frameworkvalidators.Int64ValidatorAtLeast(1),
+			}),
+			expected: frameworkvalidators.ListValidatorValueInt64sAre([]*schema.CustomValidator{
+				frameworkvalidators.Int64ValidatorAtLeast(1),
+			}),
+		},
+		"other package": {
+			validator: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "example.com/validators",
+					},
+				},
+				SchemaDefinition: "validators.Example()",
+			},
+			expectedError: "validator 'validators.Example()' has no listvalidator package equivalent",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := frameworkvalidators.ListValidatorFromSetValidator(testCase.validator)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
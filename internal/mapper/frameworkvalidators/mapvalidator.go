@@ -78,3 +78,38 @@ func MapValidatorSizeBetween(minimum, maximum int64) *schema.CustomValidator {
 		SchemaDefinition: schemaDefinition.String(),
 	}
 }
+
+// MapValidatorKeysAre returns a custom validator mapped to the mapvalidator
+// package KeysAre function, which applies the given string validators to each
+// map key. If the validators are nil or empty, nil is returned.
+func MapValidatorKeysAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(MapValidatorCodeImport, MapValidatorPackage, "KeysAre", validators)
+}
+
+// MapValidatorValueStringsAre returns a custom validator mapped to the mapvalidator
+// package ValueStringsAre function, which applies the given string validators to
+// each element. If the validators are nil or empty, nil is returned.
+func MapValidatorValueStringsAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(MapValidatorCodeImport, MapValidatorPackage, "ValueStringsAre", validators)
+}
+
+// MapValidatorValueInt64sAre returns a custom validator mapped to the mapvalidator
+// package ValueInt64sAre function, which applies the given int64 validators to
+// each element. If the validators are nil or empty, nil is returned.
+func MapValidatorValueInt64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(MapValidatorCodeImport, MapValidatorPackage, "ValueInt64sAre", validators)
+}
+
+// MapValidatorValueFloat64sAre returns a custom validator mapped to the mapvalidator
+// package ValueFloat64sAre function, which applies the given float64 validators to
+// each element. If the validators are nil or empty, nil is returned.
+func MapValidatorValueFloat64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(MapValidatorCodeImport, MapValidatorPackage, "ValueFloat64sAre", validators)
+}
+
+// MapValidatorValueNumbersAre returns a custom validator mapped to the mapvalidator
+// package ValueNumbersAre function, which applies the given number validators to
+// each element. If the validators are nil or empty, nil is returned.
+func MapValidatorValueNumbersAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(MapValidatorCodeImport, MapValidatorPackage, "ValueNumbersAre", validators)
+}
@@ -116,3 +116,141 @@ func TestMapValidatorSizeBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestMapValidatorElementValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		function   func([]*schema.CustomValidator) *schema.CustomValidator
+		validators []*schema.CustomValidator
+		expected   *schema.CustomValidator
+	}{
+		"nil": {
+			function:   frameworkvalidators.MapValidatorValueStringsAre,
+			validators: nil,
+			expected:   nil,
+		},
+		"multiple": {
+			function: frameworkvalidators.MapValidatorValueStringsAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.StringValidatorLengthAtLeast(1),
+				frameworkvalidators.StringValidatorRegexMatches("^[a-z]+$", ""),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+					{
+						Path: "regexp",
+					},
+				},
+				SchemaDefinition: "mapvalidator.ValueStringsAre(\nstringvalidator.LengthAtLeast(1),\nstringvalidator.RegexMatches(regexp.MustCompile(\"^[a-z]+$\"), \"\"),\n)",
+			},
+		},
+		"KeysAre": {
+			function: frameworkvalidators.MapValidatorKeysAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.StringValidatorOneOf([]string{"a"}),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+				},
+				SchemaDefinition: "mapvalidator.KeysAre(\nstringvalidator.OneOf(\n\"a\",\n),\n)",
+			},
+		},
+		"ValueStringsAre": {
+			function: frameworkvalidators.MapValidatorValueStringsAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.StringValidatorLengthAtMost(10),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+				},
+				SchemaDefinition: "mapvalidator.ValueStringsAre(\nstringvalidator.LengthAtMost(10),\n)",
+			},
+		},
+		"ValueInt64sAre": {
+			function: frameworkvalidators.MapValidatorValueInt64sAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.Int64ValidatorAtLeast(1),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+					},
+				},
+				SchemaDefinition: "mapvalidator.ValueInt64sAre(\nint64validator.AtLeast(1),\n)",
+			},
+		},
+		"ValueFloat64sAre": {
+			function: frameworkvalidators.MapValidatorValueFloat64sAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.Float64ValidatorAtMost(1.5),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+					},
+				},
+				SchemaDefinition: "mapvalidator.ValueFloat64sAre(\nfloat64validator.AtMost(1.5),\n)",
+			},
+		},
+		"ValueNumbersAre": {
+			function: frameworkvalidators.MapValidatorValueNumbersAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.NumberValidatorOneOf([]float64{1.5}),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+					},
+					{
+						Path: "math/big",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator",
+					},
+				},
+				SchemaDefinition: "mapvalidator.ValueNumbersAre(\nnumbervalidator.OneOf(\nbig.NewFloat(1.5),\n),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.function(testCase.validators)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
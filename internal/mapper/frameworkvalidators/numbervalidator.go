@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// NumberValidatorPackage is the name of the number validation package in
+	// the framework validators module.
+	NumberValidatorPackage = "numbervalidator"
+)
+
+var (
+	// NumberValidatorCodeImport is a single allocation of the framework
+	// validators module numbervalidator package import.
+	NumberValidatorCodeImport code.Import = CodeImport(NumberValidatorPackage)
+
+	// BigCodeImport is a single allocation of the Go standard library
+	// math/big package import, used to create *big.Float values.
+	BigCodeImport code.Import = code.Import{
+		Path: "math/big",
+	}
+)
+
+// NumberValidatorOneOf returns a custom validator mapped to the
+// numbervalidator package OneOf function. If the values are nil or empty, nil
+// is returned.
+func NumberValidatorOneOf(values []float64) *schema.CustomValidator {
+	if len(values) == 0 {
+		return nil
+	}
+
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(NumberValidatorPackage)
+	schemaDefinition.WriteString(".OneOf(\n")
+
+	for _, value := range values {
+		schemaDefinition.WriteString("big.NewFloat(" + strconv.FormatFloat(value, 'f', -1, 64) + "),\n")
+	}
+
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			BigCodeImport,
+			NumberValidatorCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+const (
+	// ObjectValidatorPackage is the name of the object validation package in
+	// the framework validators module.
+	ObjectValidatorPackage = "objectvalidator"
+
+	// PathCodeImportPath is the code import path for the framework path
+	// package, which is used for path expressions in validators.
+	PathCodeImportPath = "github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+var (
+	// ObjectValidatorCodeImport is a single allocation of the framework
+	// validators module objectvalidator package import.
+	ObjectValidatorCodeImport code.Import = CodeImport(ObjectValidatorPackage)
+
+	// PathCodeImport is a single allocation of the framework path package
+	// import.
+	PathCodeImport code.Import = code.Import{
+		Path: PathCodeImportPath,
+	}
+)
+
+// ObjectValidatorConflictsWith returns a custom validator mapped to the
+// objectvalidator package ConflictsWith function, with a path expression for
+// each of the given sibling attribute names. If the attribute names are nil or
+// empty, nil is returned.
+func ObjectValidatorConflictsWith(attributeNames []string) *schema.CustomValidator {
+	return objectValidatorWithSiblingExpressions("ConflictsWith", attributeNames)
+}
+
+// ObjectValidatorExactlyOneOf returns a custom validator mapped to the
+// objectvalidator package ExactlyOneOf function, with a path expression for
+// each of the given sibling attribute names. If the attribute names are nil or
+// empty, nil is returned.
+func ObjectValidatorExactlyOneOf(attributeNames []string) *schema.CustomValidator {
+	return objectValidatorWithSiblingExpressions("ExactlyOneOf", attributeNames)
+}
+
+func objectValidatorWithSiblingExpressions(function string, attributeNames []string) *schema.CustomValidator {
+	if len(attributeNames) == 0 {
+		return nil
+	}
+
+	var schemaDefinition strings.Builder
+
+	schemaDefinition.WriteString(ObjectValidatorPackage)
+	schemaDefinition.WriteString(".")
+	schemaDefinition.WriteString(function)
+	schemaDefinition.WriteString("(\n")
+
+	for _, attributeName := range attributeNames {
+		schemaDefinition.WriteString("path.MatchRelative().AtParent().AtName(")
+		schemaDefinition.WriteString(strconv.Quote(attributeName))
+		schemaDefinition.WriteString("),\n")
+	}
+
+	schemaDefinition.WriteString(")")
+
+	return &schema.CustomValidator{
+		Imports: []code.Import{
+			PathCodeImport,
+			ObjectValidatorCodeImport,
+		},
+		SchemaDefinition: schemaDefinition.String(),
+	}
+}
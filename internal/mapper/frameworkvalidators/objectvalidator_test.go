@@ -0,0 +1,132 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package frameworkvalidators_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+)
+
+func TestObjectValidatorConflictsWith(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeNames []string
+		expected       *schema.CustomValidator
+	}{
+		"nil": {
+			attributeNames: nil,
+			expected:       nil,
+		},
+		"empty": {
+			attributeNames: []string{},
+			expected:       nil,
+		},
+		"one": {
+			attributeNames: []string{"one"},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/path",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator",
+					},
+				},
+				SchemaDefinition: "objectvalidator.ConflictsWith(\npath.MatchRelative().AtParent().AtName(\"one\"),\n)",
+			},
+		},
+		"multiple": {
+			attributeNames: []string{"one", "two"},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/path",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator",
+					},
+				},
+				SchemaDefinition: "objectvalidator.ConflictsWith(\npath.MatchRelative().AtParent().AtName(\"one\"),\npath.MatchRelative().AtParent().AtName(\"two\"),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.ObjectValidatorConflictsWith(testCase.attributeNames)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestObjectValidatorExactlyOneOf(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		attributeNames []string
+		expected       *schema.CustomValidator
+	}{
+		"nil": {
+			attributeNames: nil,
+			expected:       nil,
+		},
+		"empty": {
+			attributeNames: []string{},
+			expected:       nil,
+		},
+		"one": {
+			attributeNames: []string{"one"},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/path",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator",
+					},
+				},
+				SchemaDefinition: "objectvalidator.ExactlyOneOf(\npath.MatchRelative().AtParent().AtName(\"one\"),\n)",
+			},
+		},
+		"multiple": {
+			attributeNames: []string{"one", "two"},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework/path",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator",
+					},
+				},
+				SchemaDefinition: "objectvalidator.ExactlyOneOf(\npath.MatchRelative().AtParent().AtName(\"one\"),\npath.MatchRelative().AtParent().AtName(\"two\"),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.ObjectValidatorExactlyOneOf(testCase.attributeNames)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
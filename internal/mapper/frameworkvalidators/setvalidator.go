@@ -78,3 +78,45 @@ func SetValidatorSizeBetween(minimum, maximum int64) *schema.CustomValidator {
 		SchemaDefinition: schemaDefinition.String(),
 	}
 }
+
+// SetValidatorValueStringsAre returns a custom validator mapped to the setvalidator
+// package ValueStringsAre function, which applies the given string validators to
+// each element. If the validators are nil or empty, nil is returned.
+func SetValidatorValueStringsAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(SetValidatorCodeImport, SetValidatorPackage, "ValueStringsAre", validators)
+}
+
+// SetValidatorValueInt64sAre returns a custom validator mapped to the setvalidator
+// package ValueInt64sAre function, which applies the given int64 validators to
+// each element. If the validators are nil or empty, nil is returned.
+func SetValidatorValueInt64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(SetValidatorCodeImport, SetValidatorPackage, "ValueInt64sAre", validators)
+}
+
+// SetValidatorValueFloat64sAre returns a custom validator mapped to the setvalidator
+// package ValueFloat64sAre function, which applies the given float64 validators to
+// each element. If the validators are nil or empty, nil is returned.
+func SetValidatorValueFloat64sAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(SetValidatorCodeImport, SetValidatorPackage, "ValueFloat64sAre", validators)
+}
+
+// SetValidatorValueNumbersAre returns a custom validator mapped to the setvalidator
+// package ValueNumbersAre function, which applies the given number validators to
+// each element. If the validators are nil or empty, nil is returned.
+func SetValidatorValueNumbersAre(validators []*schema.CustomValidator) *schema.CustomValidator {
+	return elementValidator(SetValidatorCodeImport, SetValidatorPackage, "ValueNumbersAre", validators)
+}
+
+// SetValidatorFromListValidator returns the setvalidator package equivalent
+// of a custom validator mapped to a listvalidator package function, for lists
+// that are converted to sets. The listvalidator package UniqueValues function
+// has no equivalent, as set elements are always unique, so nil is returned. An
+// error is returned for validators that can't be converted, such as validators
+// of other packages.
+func SetValidatorFromListValidator(validator *schema.CustomValidator) (*schema.CustomValidator, error) {
+	if validator != nil && validator.SchemaDefinition == ListValidatorPackage+".UniqueValues()" {
+		return nil, nil
+	}
+
+	return convertCollectionValidator(validator, ListValidatorCodeImport, ListValidatorPackage, SetValidatorCodeImport, SetValidatorPackage)
+}
@@ -116,3 +116,206 @@ func TestSetValidatorSizeBetween(t *testing.T) {
 		})
 	}
 }
+
+func TestSetValidatorElementValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		function   func([]*schema.CustomValidator) *schema.CustomValidator
+		validators []*schema.CustomValidator
+		expected   *schema.CustomValidator
+	}{
+		"nil": {
+			function:   frameworkvalidators.SetValidatorValueStringsAre,
+			validators: nil,
+			expected:   nil,
+		},
+		"multiple": {
+			function: frameworkvalidators.SetValidatorValueStringsAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.StringValidatorLengthAtLeast(1),
+				frameworkvalidators.StringValidatorRegexMatches("^[a-z]+$", ""),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+					{
+						Path: "regexp",
+					},
+				},
+				SchemaDefinition: "setvalidator.ValueStringsAre(\nstringvalidator.LengthAtLeast(1),\nstringvalidator.RegexMatches(regexp.MustCompile(\"^[a-z]+$\"), \"\"),\n)",
+			},
+		},
+		"ValueStringsAre": {
+			function: frameworkvalidators.SetValidatorValueStringsAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.StringValidatorLengthAtMost(10),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+				},
+				SchemaDefinition: "setvalidator.ValueStringsAre(\nstringvalidator.LengthAtMost(10),\n)",
+			},
+		},
+		"ValueInt64sAre": {
+			function: frameworkvalidators.SetValidatorValueInt64sAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.Int64ValidatorAtLeast(1),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+					},
+				},
+				SchemaDefinition: "setvalidator.ValueInt64sAre(\nint64validator.AtLeast(1),\n)",
+			},
+		},
+		"ValueFloat64sAre": {
+			function: frameworkvalidators.SetValidatorValueFloat64sAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.Float64ValidatorAtMost(1.5),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+					},
+				},
+				SchemaDefinition: "setvalidator.ValueFloat64sAre(\nfloat64validator.AtMost(1.5),\n)",
+			},
+		},
+		"ValueNumbersAre": {
+			function: frameworkvalidators.SetValidatorValueNumbersAre,
+			validators: []*schema.CustomValidator{
+				frameworkvalidators.NumberValidatorOneOf([]float64{1.5}),
+			},
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+					},
+					{
+						Path: "math/big",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator",
+					},
+				},
+				SchemaDefinition: "setvalidator.ValueNumbersAre(\nnumbervalidator.OneOf(\nbig.NewFloat(1.5),\n),\n)",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.function(testCase.validators)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSetValidatorFromListValidator(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		validator     *schema.CustomValidator
+		expected      *schema.CustomValidator
+		expectedError string
+	}{
+		"nil": {
+			validator: nil,
+			expected:  nil,
+		},
+		"SizeBetween": {
+			validator: frameworkvalidators.ListValidatorSizeBetween(1, 5),
+			expected:  frameworkvalidators.SetValidatorSizeBetween(1, 5),
+		},
+		"UniqueValues": {
+			validator: frameworkvalidators.ListValidatorUniqueValues(),
+			expected:  nil,
+		},
+		"ValueStringsAre": {
+			validator: frameworkvalidators.ListValidatorValueStringsAre([]*schema.CustomValidator{
+				frameworkvalidators.StringValidatorLengthAtLeast(1),
+			}),
+			expected: frameworkvalidators.SetValidatorValueStringsAre([]*schema.CustomValidator{
+				frameworkvalidators.StringValidatorLengthAtLeast(1),
+			}),
+		},
+		"other package": {
+			validator: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "example.com/validators",
+					},
+				},
+				SchemaDefinition: "validators.Example()",
+			},
+			expectedError: "validator 'validators.Example()' has no setvalidator package equivalent",
+		},
+		"no equivalent": {
+			validator: &schema.CustomValidator{
+				Imports: []code.Import{
+					frameworkvalidators.ListValidatorCodeImport,
+				},
+				SchemaDefinition: "listvalidator.PreferWriteOnlyAttribute(path.MatchRoot(\"secret\"))",
+			},
+			expectedError: "validator 'listvalidator.PreferWriteOnlyAttribute(path.MatchRoot(\"secret\"))' has no setvalidator package equivalent",
+		},
+		"validators of own package": {
+			validator: &schema.CustomValidator{
+				Imports: []code.Import{
+					frameworkvalidators.ListValidatorCodeImport,
+				},
+				SchemaDefinition: "listvalidator.All(listvalidator.SizeAtLeast(1))",
+			},
+			expectedError: "validator 'listvalidator.All(listvalidator.SizeAtLeast(1))' has no setvalidator package equivalent",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := frameworkvalidators.SetValidatorFromListValidator(testCase.validator)
+			if testCase.expectedError != "" {
+				if err == nil || err.Error() != testCase.expectedError {
+					t.Fatalf("expected error %q, got: %v", testCase.expectedError, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
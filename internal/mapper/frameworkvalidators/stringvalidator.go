@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 )
@@ -23,6 +24,45 @@ var (
 	StringValidatorCodeImport code.Import = CodeImport(StringValidatorPackage)
 )
 
+// stringFormatRegex is a regular expression pattern, with a friendly error
+// message, that validates a well-known string format.
+type stringFormatRegex struct {
+	pattern string
+	message string
+}
+
+// stringFormatRegexes are the well-known string formats that are validated
+// with the stringvalidator package RegexMatches function. The patterns are
+// intentionally lenient, to catch malformed values at plan time without
+// rejecting values the API may accept.
+var stringFormatRegexes = map[string]stringFormatRegex{
+	// There is no framework custom type for a CIDR block that can be either IPv4 or IPv6, so these are validated instead
+	util.OAS_format_cidr: {
+		pattern: `^(((25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)\.){3}(25[0-5]|2[0-4]\d|1\d\d|[1-9]?\d)/(3[0-2]|[12]?\d)|[0-9a-fA-F:.]*:[0-9a-fA-F:.]*/(12[0-8]|1[01]\d|[1-9]?\d))$`,
+		message: "must be a valid IPv4 or IPv6 CIDR block",
+	},
+	util.OAS_format_date: {
+		pattern: `^\d{4}-(0[1-9]|1[0-2])-(0[1-9]|[12]\d|3[01])$`,
+		message: "must be a valid RFC 3339 full-date (YYYY-MM-DD)",
+	},
+	util.OAS_format_email: {
+		pattern: `^[^@\s]+@[^@\s]+\.[^@\s]+$`,
+		message: "must be a valid email address",
+	},
+	util.OAS_format_hostname: {
+		pattern: `^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$`,
+		message: "must be a valid hostname",
+	},
+	util.OAS_format_uri: {
+		pattern: `^[a-zA-Z][a-zA-Z0-9+.-]*:\S*$`,
+		message: "must be a valid URI, including the scheme",
+	},
+	util.OAS_format_uuid: {
+		pattern: `^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`,
+		message: "must be a valid UUID",
+	},
+}
+
 // StringValidatorLengthAtLeast returns a custom validator mapped to the
 // stringvalidator package LengthAtLeast function.
 func StringValidatorLengthAtLeast(minimum int64) *schema.CustomValidator {
@@ -129,3 +169,15 @@ func StringValidatorRegexMatches(pattern, message string) *schema.CustomValidato
 		SchemaDefinition: schemaDefinition.String(),
 	}
 }
+
+// StringValidatorFormat returns a custom validator mapped to the
+// stringvalidator package RegexMatches function for a well-known string
+// format, such as uuid or email. Returns nil if the format has no validator.
+func StringValidatorFormat(format string) *schema.CustomValidator {
+	formatRegex, ok := stringFormatRegexes[format]
+	if !ok {
+		return nil
+	}
+
+	return StringValidatorRegexMatches(formatRegex.pattern, formatRegex.message)
+}
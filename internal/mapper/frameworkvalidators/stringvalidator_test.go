@@ -4,6 +4,8 @@
 package frameworkvalidators_test
 
 import (
+	"regexp"
+	"strconv"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -238,3 +240,142 @@ func TestStringValidatorRegexMatches(t *testing.T) {
 		})
 	}
 }
+
+func TestStringValidatorFormat(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		format   string
+		expected *schema.CustomValidator
+	}{
+		"no format": {
+			format:   "",
+			expected: nil,
+		},
+		"unsupported format": {
+			format:   "password",
+			expected: nil,
+		},
+		"uuid": {
+			format: "uuid",
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "regexp",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+				},
+				SchemaDefinition: "stringvalidator.RegexMatches(regexp.MustCompile(\"^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$\"), \"must be a valid UUID\")",
+			},
+		},
+		"email": {
+			format: "email",
+			expected: &schema.CustomValidator{
+				Imports: []code.Import{
+					{
+						Path: "regexp",
+					},
+					{
+						Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+					},
+				},
+				SchemaDefinition: "stringvalidator.RegexMatches(regexp.MustCompile(\"^[^@\\\\s]+@[^@\\\\s]+\\\\.[^@\\\\s]+$\"), \"must be a valid email address\")",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := frameworkvalidators.StringValidatorFormat(testCase.format)
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestStringValidatorFormat_Patterns(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		format  string
+		valid   []string
+		invalid []string
+	}{
+		"cidr": {
+			format:  "cidr",
+			valid:   []string{"10.0.0.0/8", "192.168.1.0/24", "0.0.0.0/0", "2001:db8::/32", "::/0", "fe80::1/128"},
+			invalid: []string{"10.0.0.0", "10.0.0.0/33", "256.0.0.0/8", "2001:db8::/129", "example.com/24"},
+		},
+		"date": {
+			format:  "date",
+			valid:   []string{"2023-01-31", "1999-12-01"},
+			invalid: []string{"2023-1-31", "2023-13-01", "2023-01-32", "2023-01-31T00:00:00Z"},
+		},
+		"email": {
+			format:  "email",
+			valid:   []string{"user@example.com", "first.last+tag@sub.example.co"},
+			invalid: []string{"user", "user@", "@example.com", "user@example", "us er@example.com"},
+		},
+		"hostname": {
+			format:  "hostname",
+			valid:   []string{"localhost", "example.com", "my-host.example.com"},
+			invalid: []string{"-example.com", "example-.com", "exa_mple.com", "example..com"},
+		},
+		"uri": {
+			format:  "uri",
+			valid:   []string{"https://example.com/path?query=1", "urn:isbn:0451450523", "mailto:user@example.com"},
+			invalid: []string{"/relative/path", "example.com", "https://example.com/with space"},
+		},
+		"uuid": {
+			format:  "uuid",
+			valid:   []string{"123e4567-e89b-12d3-a456-426614174000", "123E4567-E89B-12D3-A456-426614174000"},
+			invalid: []string{"123e4567e89b12d3a456426614174000", "123e4567-e89b-12d3-a456-42661417400", "g23e4567-e89b-12d3-a456-426614174000"},
+		},
+	}
+
+	// Extracts the quoted regex pattern from the validator schema definition
+	patternRegex := regexp.MustCompile(`regexp\.MustCompile\(("(?:[^"\\]|\\.)*")\)`)
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := frameworkvalidators.StringValidatorFormat(testCase.format)
+			if validator == nil {
+				t.Fatalf("expected validator for format %q", testCase.format)
+			}
+
+			matches := patternRegex.FindStringSubmatch(validator.SchemaDefinition)
+			if len(matches) != 2 {
+				t.Fatalf("unable to find pattern in schema definition: %s", validator.SchemaDefinition)
+			}
+
+			pattern, err := strconv.Unquote(matches[1])
+			if err != nil {
+				t.Fatalf("unexpected error unquoting pattern: %s", err)
+			}
+
+			formatRegex := regexp.MustCompile(pattern)
+
+			for _, value := range testCase.valid {
+				if !formatRegex.MatchString(value) {
+					t.Errorf("expected %q to be valid", value)
+				}
+			}
+
+			for _, value := range testCase.invalid {
+				if formatRegex.MatchString(value) {
+					t.Errorf("expected %q to be invalid", value)
+				}
+			}
+		})
+	}
+}
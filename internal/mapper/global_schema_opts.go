@@ -0,0 +1,76 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworktypes"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// newGlobalSchemaOpts returns the global schema options from the generator config, which apply to every schema of all
+// resources, data sources, and the provider.
+func newGlobalSchemaOpts(cfg config.Config) oas.GlobalSchemaOpts {
+	return oas.GlobalSchemaOpts{
+		FormatCustomTypes:     formatCustomTypes(cfg.Formats),
+		UniqueItemsAsSets:     cfg.UniqueItemsAsSets,
+		IgnoreConstAttributes: cfg.IgnoreConstAttributes,
+		MultipleOfValidators: oas.MultipleOfValidators{
+			Int64:   validatorFunction(cfg.MultipleOfValidators.Int64),
+			Float64: validatorFunction(cfg.MultipleOfValidators.Float64),
+			Number:  validatorFunction(cfg.MultipleOfValidators.Number),
+		},
+	}
+}
+
+// validatorFunction returns the validator function from the generator config, or nil if there is none.
+func validatorFunction(function *config.ValidatorFunction) *oas.ValidatorFunction {
+	if function == nil {
+		return nil
+	}
+
+	result := &oas.ValidatorFunction{
+		Function: function.Function,
+	}
+	if function.Import != "" {
+		result.Import = &code.Import{
+			Path: function.Import,
+		}
+	}
+
+	return result
+}
+
+// formatCustomTypes returns the default framework custom types for string formats, with any custom types from the generator
+// config applied. An empty custom type in the generator config removes the default custom type for that format.
+func formatCustomTypes(formats map[string]config.Format) map[string]*schema.CustomType {
+	customTypes := frameworktypes.DefaultFormatCustomTypes()
+
+	for format, formatCfg := range formats {
+		if formatCfg.CustomType == nil {
+			continue
+		}
+
+		if *formatCfg.CustomType == (config.CustomType{}) {
+			delete(customTypes, format)
+			continue
+		}
+
+		customType := &schema.CustomType{
+			Type:      formatCfg.CustomType.Type,
+			ValueType: formatCfg.CustomType.ValueType,
+		}
+		if formatCfg.CustomType.Import != "" {
+			customType.Import = &code.Import{
+				Path: formatCfg.CustomType.Import,
+			}
+		}
+
+		customTypes[format] = customType
+	}
+
+	return customTypes
+}
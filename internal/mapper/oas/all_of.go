@@ -0,0 +1,238 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+)
+
+// mergeAllOf will deep-merge the parent schema and all of its allOf subschemas into a single effective schema. The parent schema
+// is treated as the first subschema, so its own properties and keywords (description, required, etc.) take precedence.
+//
+// Subschemas are merged with the following rules:
+//   - type: All subschemas that define a type must agree, otherwise a SchemaError is returned
+//   - properties: Merged together, duplicate properties are recursively merged with allOf and must have compatible types
+//   - required: Combined from all subschemas
+//   - description, format, pattern, enum, default, etc.: The first populated value is used
+//   - minimum, maxLength, minItems, etc.: The most restrictive value is used, as all subschemas must validate
+func mergeAllOf(s *base.Schema) (*base.Schema, *SchemaError) {
+	subschemas := []*base.Schema{parentWithoutAllOf(s)}
+
+	for _, allOfProxy := range s.AllOf {
+		allOfSchema, err := buildSchemaProxy(allOfProxy)
+		if err != nil {
+			return nil, err
+		}
+
+		subschemas = append(subschemas, allOfSchema)
+	}
+
+	merged := &base.Schema{
+		ParentProxy: s.ParentProxy,
+	}
+
+	var mergedType string
+	for _, subschema := range subschemas {
+		if len(subschema.Type) == 0 && (subschema.Properties == nil || subschema.Properties.Len() == 0) {
+			// Subschemas without a type can still contribute keywords, like description or required
+			mergeAllOfKeywords(merged, subschema)
+			continue
+		}
+
+		subschemaType, err := retrieveType(subschema)
+		if err != nil {
+			return nil, err
+		}
+
+		if mergedType == "" {
+			mergedType = subschemaType
+			merged.Type = subschema.Type
+		} else if mergedType != subschemaType {
+			return nil, SchemaErrorFromNode(fmt.Errorf("allOf subschemas have incompatible types [%s %s]", mergedType, subschemaType), s, AllOf)
+		}
+
+		err = mergeAllOfProperties(merged, subschema, s)
+		if err != nil {
+			return nil, err
+		}
+
+		mergeAllOfKeywords(merged, subschema)
+	}
+
+	return merged, nil
+}
+
+// parentWithoutAllOf returns a shallow copy of the parent schema without the allOf keyword, so it can be merged with the subschemas.
+func parentWithoutAllOf(s *base.Schema) *base.Schema {
+	parent := *s
+	parent.AllOf = nil
+
+	return &parent
+}
+
+// mergeAllOfProperties adds all properties from the subschema to the merged schema. If a property is already defined in the merged
+// schema, both property schemas are combined with allOf, which will be merged when the property schema is built.
+func mergeAllOfProperties(merged *base.Schema, subschema *base.Schema, parent *base.Schema) *SchemaError {
+	if subschema.Properties == nil {
+		return nil
+	}
+
+	if merged.Properties == nil {
+		merged.Properties = orderedmap.New[string, *base.SchemaProxy]()
+	}
+
+	for pair := range orderedmap.Iterate(context.TODO(), subschema.Properties) {
+		name := pair.Key()
+		proxy := pair.Value()
+
+		existingProxy, ok := merged.Properties.Get(name)
+		if !ok {
+			merged.Properties.Set(name, proxy)
+			continue
+		}
+
+		existingSchema, err := buildSchemaProxy(existingProxy)
+		if err != nil {
+			return err.NestedSchemaError(name, 0)
+		}
+
+		newSchema, err := buildSchemaProxy(proxy)
+		if err != nil {
+			return err.NestedSchemaError(name, 0)
+		}
+
+		existingType, err := retrieveType(existingSchema)
+		if err != nil {
+			return err.NestedSchemaError(name, 0)
+		}
+
+		newType, err := retrieveType(newSchema)
+		if err != nil {
+			return err.NestedSchemaError(name, 0)
+		}
+
+		if existingType != newType {
+			return SchemaErrorFromNode(
+				fmt.Errorf("allOf subschemas define property '%s' with incompatible types [%s %s]", name, existingType, newType),
+				parent,
+				AllOf,
+			).NestedSchemaError(name, 0)
+		}
+
+		merged.Properties.Set(name, base.CreateSchemaProxy(&base.Schema{
+			AllOf: []*base.SchemaProxy{existingProxy, proxy},
+		}))
+	}
+
+	return nil
+}
+
+// mergeAllOfKeywords merges all non-property keywords from the subschema into the merged schema.
+func mergeAllOfKeywords(merged *base.Schema, subschema *base.Schema) {
+	for _, required := range subschema.Required {
+		if !slices.Contains(merged.Required, required) {
+			merged.Required = append(merged.Required, required)
+		}
+	}
+
+	if merged.Description == "" {
+		merged.Description = subschema.Description
+	}
+	if merged.Title == "" {
+		merged.Title = subschema.Title
+	}
+	if merged.Format == "" {
+		merged.Format = subschema.Format
+	}
+	if merged.Pattern == "" {
+		merged.Pattern = subschema.Pattern
+	}
+	if len(merged.Enum) == 0 {
+		merged.Enum = subschema.Enum
+	}
+	if merged.Default == nil {
+		merged.Default = subschema.Default
+	}
+	if merged.Const == nil {
+		merged.Const = subschema.Const
+	}
+	if merged.Deprecated == nil {
+		merged.Deprecated = subschema.Deprecated
+	}
+	if merged.Nullable == nil {
+		merged.Nullable = subschema.Nullable
+	}
+	if merged.ReadOnly == nil {
+		merged.ReadOnly = subschema.ReadOnly
+	}
+	if merged.WriteOnly == nil {
+		merged.WriteOnly = subschema.WriteOnly
+	}
+	if merged.UniqueItems == nil {
+		merged.UniqueItems = subschema.UniqueItems
+	}
+	if merged.Items == nil {
+		merged.Items = subschema.Items
+	}
+	if merged.AdditionalProperties == nil {
+		merged.AdditionalProperties = subschema.AdditionalProperties
+	}
+	if merged.PropertyNames == nil {
+		merged.PropertyNames = subschema.PropertyNames
+	}
+	if merged.MultipleOf == nil {
+		merged.MultipleOf = subschema.MultipleOf
+	}
+	if merged.ExclusiveMinimum == nil {
+		merged.ExclusiveMinimum = subschema.ExclusiveMinimum
+	}
+	if merged.ExclusiveMaximum == nil {
+		merged.ExclusiveMaximum = subschema.ExclusiveMaximum
+	}
+	if merged.Discriminator == nil {
+		merged.Discriminator = subschema.Discriminator
+	}
+	if merged.Extensions == nil {
+		merged.Extensions = subschema.Extensions
+	}
+
+	// All subschemas must validate, so the most restrictive bounds are used
+	merged.Minimum = greatest(merged.Minimum, subschema.Minimum)
+	merged.Maximum = least(merged.Maximum, subschema.Maximum)
+	merged.MinLength = greatest(merged.MinLength, subschema.MinLength)
+	merged.MaxLength = least(merged.MaxLength, subschema.MaxLength)
+	merged.MinItems = greatest(merged.MinItems, subschema.MinItems)
+	merged.MaxItems = least(merged.MaxItems, subschema.MaxItems)
+	merged.MinProperties = greatest(merged.MinProperties, subschema.MinProperties)
+	merged.MaxProperties = least(merged.MaxProperties, subschema.MaxProperties)
+}
+
+// greatest returns the larger of two values if both are populated, otherwise returns the populated value.
+func greatest[T int64 | float64](target *T, merge *T) *T {
+	if target == nil {
+		return merge
+	}
+	if merge == nil || *target >= *merge {
+		return target
+	}
+
+	return merge
+}
+
+// least returns the smaller of two values if both are populated, otherwise returns the populated value.
+func least[T int64 | float64](target *T, merge *T) *T {
+	if target == nil {
+		return merge
+	}
+	if merge == nil || *target <= *merge {
+		return target
+	}
+
+	return merge
+}
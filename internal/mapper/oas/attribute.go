@@ -20,13 +20,20 @@ func (s *OASSchema) BuildResourceAttributes() (attrmapper.ResourceAttributes, *S
 	for pair := range orderedmap.Iterate(context.TODO(), sortedProperties) {
 		name := pair.Key()
 
-		if s.IsPropertyIgnored(name) {
+		// Ignores and attribute options use the aliased attribute name, like aliased parameters
+		attributeName := s.GetAlias(name)
+
+		if s.IsPropertyIgnored(attributeName) {
 			continue
 		}
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Aliases:           s.GetAliasesForNested(name),
+			Ignores:           s.GetIgnoresForNested(attributeName),
+			ObjectUnion:       s.GetObjectUnion(),
+			UniqueItemsAsSet:  s.GetUniqueItemsAsSet(attributeName),
+			UniqueItemsAsSets: s.GetUniqueItemsAsSetsForNested(attributeName),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -34,7 +41,11 @@ func (s *OASSchema) BuildResourceAttributes() (attrmapper.ResourceAttributes, *S
 			return nil, s.NestSchemaError(err, name)
 		}
 
-		attribute, err := pSchema.BuildResourceAttribute(name, s.GetComputability(name))
+		if pSchema.IsConstIgnored() {
+			continue
+		}
+
+		attribute, err := pSchema.BuildResourceAttribute(attributeName, s.GetComputability(name))
 		if err != nil {
 			return nil, err
 		}
@@ -50,6 +61,12 @@ func (s *OASSchema) BuildResourceAttribute(name string, computability schema.Com
 		return nil, s.SchemaErrorFromProperty(fmt.Errorf("'%s' cannot be converted to a valid Terraform identifier", name), name)
 	}
 
+	// Nullable response fields can be set to null in configuration, so they're optional instead of only computed. Read-only
+	// fields are only ever set by the API, even when the API may return null.
+	if s.IsNullable() && !s.IsReadOnly() && computability == schema.Computed {
+		computability = schema.ComputedOptional
+	}
+
 	switch s.Type {
 	case util.OAS_type_string:
 		return s.BuildStringResource(name, computability)
@@ -78,13 +95,20 @@ func (s *OASSchema) BuildDataSourceAttributes() (attrmapper.DataSourceAttributes
 	for pair := range orderedmap.Iterate(context.TODO(), sortedProperties) {
 		name := pair.Key()
 
-		if s.IsPropertyIgnored(name) {
+		// Ignores and attribute options use the aliased attribute name, like aliased parameters
+		attributeName := s.GetAlias(name)
+
+		if s.IsPropertyIgnored(attributeName) {
 			continue
 		}
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Aliases:           s.GetAliasesForNested(name),
+			Ignores:           s.GetIgnoresForNested(attributeName),
+			ObjectUnion:       s.GetObjectUnion(),
+			UniqueItemsAsSet:  s.GetUniqueItemsAsSet(attributeName),
+			UniqueItemsAsSets: s.GetUniqueItemsAsSetsForNested(attributeName),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -92,7 +116,11 @@ func (s *OASSchema) BuildDataSourceAttributes() (attrmapper.DataSourceAttributes
 			return nil, s.NestSchemaError(err, name)
 		}
 
-		attribute, err := pSchema.BuildDataSourceAttribute(name, s.GetComputability(name))
+		if pSchema.IsConstIgnored() {
+			continue
+		}
+
+		attribute, err := pSchema.BuildDataSourceAttribute(attributeName, s.GetComputability(name))
 		if err != nil {
 			return nil, err
 		}
@@ -142,7 +170,10 @@ func (s *OASSchema) BuildProviderAttributes() (attrmapper.ProviderAttributes, *S
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Ignores:           s.GetIgnoresForNested(name),
+			ObjectUnion:       s.GetObjectUnion(),
+			UniqueItemsAsSet:  s.GetUniqueItemsAsSet(name),
+			UniqueItemsAsSets: s.GetUniqueItemsAsSetsForNested(name),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -150,6 +181,10 @@ func (s *OASSchema) BuildProviderAttributes() (attrmapper.ProviderAttributes, *S
 			return nil, s.NestSchemaError(err, name)
 		}
 
+		if pSchema.IsConstIgnored() {
+			continue
+		}
+
 		attribute, err := pSchema.BuildProviderAttribute(name, s.GetOptionalOrRequired(name))
 		if err != nil {
 			return nil, err
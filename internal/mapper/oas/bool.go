@@ -21,9 +21,9 @@ func (s *OASSchema) BuildBoolResource(name string, computability schema.Computed
 		},
 	}
 
-	if s.Schema.Default != nil {
+	if defaultNode := s.getDefault(); defaultNode != nil {
 		var staticDefault bool
-		if err := s.Schema.Default.Decode(&staticDefault); err == nil {
+		if err := defaultNode.Decode(&staticDefault); err == nil {
 			if computability == schema.Required {
 				result.ComputedOptionalRequired = schema.ComputedOptional
 			}
@@ -31,6 +31,8 @@ func (s *OASSchema) BuildBoolResource(name string, computability schema.Computed
 			result.Default = &schema.BoolDefault{
 				Static: &staticDefault,
 			}
+		} else {
+			s.warnOnInvalidDefault(name, err)
 		}
 	}
 
@@ -118,11 +118,11 @@ func BuildSchema(proxy *base.SchemaProxy, schemaOpts SchemaOpts, globalOpts Glob
 }
 
 // buildSchemaProxy is a helper function that builds a schema proxy. If needed, it will recursively resolve a specific set of [schema composition] keywords:
-//   - allOf: If len == 1, will resolve with that one item.
-//   - anyOf: If len == 2, will resolve nullable or stringable types
-//   - oneOf: If len == 2, will resolve nullable or stringable types
+//   - allOf: If len == 1, will resolve with that one item. Otherwise, all subschemas will be merged into one schema.
+//   - anyOf: If all subschemas are objects, will resolve to an object union. If len == 2, will resolve nullable or stringable types
+//   - oneOf: If all subschemas are objects, will resolve to an object union. If len == 2, will resolve nullable or stringable types
 //
-// # Any other combinations of allOf, anyOf, or oneOf will return a SchemaError
+// # Any other combinations of anyOf or oneOf will return a SchemaError
 //
 // [schema composition]: https://json-schema.org/understanding-json-schema/reference/combining
 func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
@@ -137,12 +137,24 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 	}
 
 	if len(s.AnyOf) > 0 {
+		unionSchema, err := buildObjectUnion(s, AnyOf)
+		if err != nil {
+			return nil, err
+		}
+		if unionSchema != nil {
+			return unionSchema, nil
+		}
+
 		if len(s.AnyOf) == 2 {
 			schema, err := getMultiTypeSchema(s.AnyOf[0], s.AnyOf[1])
 			if err != nil {
 				return nil, err
 			}
 
+			if s.Nullable != nil && *s.Nullable {
+				return nullableSchema(schema), nil
+			}
+
 			return schema, nil
 		}
 
@@ -151,12 +163,24 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 	}
 
 	if len(s.OneOf) > 0 {
+		unionSchema, err := buildObjectUnion(s, OneOf)
+		if err != nil {
+			return nil, err
+		}
+		if unionSchema != nil {
+			return unionSchema, nil
+		}
+
 		if len(s.OneOf) == 2 {
 			schema, err := getMultiTypeSchema(s.OneOf[0], s.OneOf[1])
 			if err != nil {
 				return nil, err
 			}
 
+			if s.Nullable != nil && *s.Nullable {
+				return nullableSchema(schema), nil
+			}
+
 			return schema, nil
 		}
 
@@ -164,8 +188,8 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 		return nil, SchemaErrorFromNode(fmt.Errorf("found %d oneOf subschema(s), schema composition is currently not supported", len(s.OneOf)), s, OneOf)
 	}
 
-	// If there is just one allOf, we can use it as the schema
-	if len(s.AllOf) == 1 {
+	// If there is just one allOf and no properties to merge, we can use it as the schema
+	if len(s.AllOf) == 1 && (s.Properties == nil || s.Properties.Len() == 0) {
 		allOfSchema, err := buildSchemaProxy(s.AllOf[0])
 		if err != nil {
 			return nil, err
@@ -176,12 +200,17 @@ func buildSchemaProxy(proxy *base.SchemaProxy) (*base.Schema, *SchemaError) {
 			allOfSchema.Description = s.Description
 		}
 
+		// OAS 3.0 specs mark a referenced schema as nullable with a single allOf, as siblings of $ref are ignored
+		if s.Nullable != nil && *s.Nullable {
+			return nullableSchema(allOfSchema), nil
+		}
+
 		return allOfSchema, nil
 	}
 
-	// Combining multiple allOf schemas and their properties is possible here, but currently not supported
+	// Combine the parent schema and all allOf subschemas into one schema
 	// See: https://github.com/hashicorp/terraform-plugin-codegen-openapi/issues/56
-	return nil, SchemaErrorFromNode(fmt.Errorf("found %d allOf subschema(s), schema composition is currently not supported", len(s.AllOf)), s, AllOf)
+	return mergeAllOf(s)
 }
 
 // getMultiTypeSchema will check the types of both schemas provided and will return the non-null schema. If a null schema type is not
@@ -209,9 +238,9 @@ func getMultiTypeSchema(proxyOne *base.SchemaProxy, proxyTwo *base.SchemaProxy)
 
 	// Check for null type, if found, return the other type
 	if firstType == util.OAS_type_null {
-		return secondSchema, nil
+		return nullableSchema(secondSchema), nil
 	} else if secondType == util.OAS_type_null {
-		return firstSchema, nil
+		return nullableSchema(firstSchema), nil
 	}
 
 	// Check for string type, if the other type can be represented as a string, return the string type
@@ -224,6 +253,16 @@ func getMultiTypeSchema(proxyOne *base.SchemaProxy, proxyTwo *base.SchemaProxy)
 	return nil, SchemaErrorFromNode(fmt.Errorf("[%s %s] - %w", firstType, secondType, ErrMultiTypeSchema), firstSchema, Type)
 }
 
+// nullableSchema returns a copy of the schema marked as nullable, so the nullability of a null subschema is kept after the
+// subschemas are resolved. The schema is copied as built schemas are cached and shared by every reference to them.
+func nullableSchema(schema *base.Schema) *base.Schema {
+	nullable := true
+	nullableCopy := *schema
+	nullableCopy.Nullable = &nullable
+
+	return &nullableCopy
+}
+
 // retrieveType will return the JSON schema type. Support for multi-types is restricted to combinations of "null" and another type, i.e. ["null", "string"]
 func retrieveType(schema *base.Schema) (string, *SchemaError) {
 	switch len(schema.Type) {
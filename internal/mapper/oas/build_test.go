@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
@@ -965,6 +966,123 @@ func TestBuildSchema_MultiTypes(t *testing.T) {
 	}
 }
 
+func TestBuildSchema_Nullable(t *testing.T) {
+	t.Parallel()
+
+	stringSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"string"},
+	})
+	nullSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"null"},
+	})
+	objectSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"string": stringSchema,
+		}),
+	})
+
+	testCases := map[string]struct {
+		schemaProxy        *base.SchemaProxy
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"nullable response fields": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"not_nullable": stringSchema,
+					"nullable": base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"string"},
+						Nullable: pointer(true),
+					}),
+					"nullable_all_of": base.CreateSchemaProxy(&base.Schema{
+						Nullable: pointer(true),
+						AllOf:    []*base.SchemaProxy{objectSchema},
+					}),
+					"nullable_one_of": base.CreateSchemaProxy(&base.Schema{
+						OneOf: []*base.SchemaProxy{stringSchema, nullSchema},
+					}),
+					"nullable_read_only": base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"string"},
+						Nullable: pointer(true),
+						ReadOnly: pointer(true),
+					}),
+					"nullable_type_array": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string", "null"},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "not_nullable",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "nullable",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "nullable_all_of",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceStringAttribute{
+							Name: "string",
+							StringAttribute: resource.StringAttribute{
+								ComputedOptionalRequired: schema.Computed,
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "nullable_one_of",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "nullable_read_only",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Computed,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "nullable_type_array",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{OverrideComputability: schema.Computed})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, err := schema.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestBuildSchema_AllOfSchemaComposition(t *testing.T) {
 	t.Parallel()
 
@@ -1065,6 +1183,316 @@ func TestBuildSchema_AllOfSchemaComposition(t *testing.T) {
 				},
 			},
 		},
+		"allOf with multiple elements - merge properties and required": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Description: "hey there! I'm the parent description.",
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"object"},
+						Description: "hey there! I'm the base model.",
+						Required:    []string{"id"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"id": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"string"},
+								Description: "hey there! I'm the id.",
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type:     []string{"object"},
+						Required: []string{"name"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"name": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"string"},
+								Description: "hey there! I'm the name.",
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						AllOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"count": base.CreateSchemaProxy(&base.Schema{
+										Type:        []string{"integer"},
+										Description: "hey there! I'm from a nested allOf.",
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+							}),
+						},
+					}),
+				},
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceInt64Attribute{
+					Name: "count",
+					Int64Attribute: resource.Int64Attribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm from a nested allOf."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "id",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm the id."),
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm the name."),
+					},
+				},
+			},
+		},
+		"allOf with multiple elements - merge duplicate properties": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"name": base.CreateSchemaProxy(&base.Schema{
+						Type:      []string{"string"},
+						MaxLength: pointer(int64(10)),
+					}),
+				}),
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"name": base.CreateSchemaProxy(&base.Schema{
+								Type:        []string{"string"},
+								Description: "hey there! I'm the name.",
+								MinLength:   pointer(int64(1)),
+								MaxLength:   pointer(int64(20)),
+							}),
+						}),
+					}),
+				},
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "name",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Description:              pointer("hey there! I'm the name."),
+						Validators: []schema.StringValidator{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+										},
+									},
+									SchemaDefinition: "stringvalidator.LengthBetween(1, 10)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			schema, err := oas.BuildSchema(testCase.schemaProxy, oas.SchemaOpts{}, oas.GlobalSchemaOpts{})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			attributes, err := schema.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildSchema_ObjectUnionSchemaComposition(t *testing.T) {
+	t.Parallel()
+
+	objectValidator := func(function string, attributeNames ...string) []schema.ObjectValidator {
+		definition := "objectvalidator." + function + "(\n"
+		for _, attributeName := range attributeNames {
+			definition += "path.MatchRelative().AtParent().AtName(\"" + attributeName + "\"),\n"
+		}
+		definition += ")"
+
+		return []schema.ObjectValidator{
+			{
+				Custom: &schema.CustomValidator{
+					Imports: []code.Import{
+						{
+							Path: "github.com/hashicorp/terraform-plugin-framework/path",
+						},
+						{
+							Path: "github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator",
+						},
+					},
+					SchemaDefinition: definition,
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		schemaProxy        *base.SchemaProxy
+		expectedAttributes attrmapper.ResourceAttributes
+	}{
+		"oneOf with objects - exactly one of validators": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"source"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"source": base.CreateSchemaProxy(&base.Schema{
+						Description: "hey there! I'm a oneOf union.",
+						OneOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Title:    "s3",
+								Type:     []string{"object"},
+								Required: []string{"bucket"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"bucket": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Title: "httpSource",
+								Type:  []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"url": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "source",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "httpSource",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "url",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators:               objectValidator("ExactlyOneOf", "s3"),
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "s3",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceStringAttribute{
+									Name: "bucket",
+									StringAttribute: resource.StringAttribute{
+										ComputedOptionalRequired: schema.Required,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators:               objectValidator("ExactlyOneOf", "http_source"),
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Description:              pointer("hey there! I'm a oneOf union."),
+					},
+				},
+			},
+		},
+		"anyOf with objects and null - conflicts with validators": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"target": base.CreateSchemaProxy(&base.Schema{
+						AnyOf: []*base.SchemaProxy{
+							base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"null"},
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Title: "one",
+								Type:  []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"bool": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"boolean"},
+									}),
+								}),
+							}),
+							base.CreateSchemaProxy(&base.Schema{
+								Title: "two",
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"bool": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"boolean"},
+									}),
+								}),
+							}),
+						},
+					}),
+				}),
+			}),
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceSingleNestedAttribute{
+					Name: "target",
+					Attributes: attrmapper.ResourceAttributes{
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "one",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceBoolAttribute{
+									Name: "bool",
+									BoolAttribute: resource.BoolAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators:               objectValidator("ConflictsWith", "two"),
+							},
+						},
+						&attrmapper.ResourceSingleNestedAttribute{
+							Name: "two",
+							Attributes: attrmapper.ResourceAttributes{
+								&attrmapper.ResourceBoolAttribute{
+									Name: "bool",
+									BoolAttribute: resource.BoolAttribute{
+										ComputedOptionalRequired: schema.ComputedOptional,
+									},
+								},
+							},
+							SingleNestedAttribute: resource.SingleNestedAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+								Validators:               objectValidator("ConflictsWith", "one"),
+							},
+						},
+					},
+					SingleNestedAttribute: resource.SingleNestedAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1134,7 +1562,7 @@ func TestBuildSchema_Errors(t *testing.T) {
 			}),
 			expectedErrRegex: `\[object string\] - unsupported multi-type, attribute cannot be created`,
 		},
-		"too many allOf": {
+		"allOf with incompatible types": {
 			schemaProxy: base.CreateSchemaProxy(&base.Schema{
 				AllOf: []*base.SchemaProxy{
 					base.CreateSchemaProxy(&base.Schema{
@@ -1145,7 +1573,65 @@ func TestBuildSchema_Errors(t *testing.T) {
 					}),
 				},
 			}),
-			expectedErrRegex: `found 2 allOf subschema\(s\), schema composition is currently not supported`,
+			expectedErrRegex: `allOf subschemas have incompatible types \[null string\]`,
+		},
+		"allOf with conflicting types across multiple subschemas": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"id": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Description: "hey there! I only contribute a description.",
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschemas have incompatible types \[object integer\]`,
+		},
+		"allOf with incompatible property types": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				AllOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"id": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"id": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						}),
+					}),
+				},
+			}),
+			expectedErrRegex: `allOf subschemas define property 'id' with incompatible types \[string integer\]`,
+		},
+		"oneOf objects without names": {
+			schemaProxy: base.CreateSchemaProxy(&base.Schema{
+				OneOf: []*base.SchemaProxy{
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+					}),
+					base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+					}),
+				},
+			}),
+			expectedErrRegex: `unable to determine a name for oneOf subschema at index 0, a \$ref, discriminator mapping, or title is required`,
 		},
 		"too many anyOf": {
 			schemaProxy: base.CreateSchemaProxy(&base.Schema{
@@ -7,6 +7,7 @@ import (
 	"errors"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
@@ -21,7 +22,9 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Aliases:           s.SchemaOpts.Aliases,
+		Ignores:           s.SchemaOpts.Ignores,
+		UniqueItemsAsSets: s.SchemaOpts.UniqueItemsAsSets,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -35,7 +38,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 			return nil, s.NestSchemaError(err, name)
 		}
 
-		if s.Format == util.TF_format_set {
+		if s.IsSet() {
 			result := &attrmapper.ResourceSetNestedAttribute{
 				Name: name,
 				NestedObject: attrmapper.ResourceNestedAttributeObject{
@@ -48,6 +51,8 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 				},
 			}
 
+			result.DefaultValue = s.getDefaultValue(name, itemSchema, frameworkdefaults.SetDefaultStaticValue)
+
 			if computability != schema.Computed {
 				result.Validators = s.GetSetValidators()
 			}
@@ -67,6 +72,8 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 			},
 		}
 
+		result.DefaultValue = s.getDefaultValue(name, itemSchema, frameworkdefaults.ListDefaultStaticValue)
+
 		if computability != schema.Computed {
 			result.Validators = s.GetListValidators()
 		}
@@ -79,7 +86,7 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	if s.Format == util.TF_format_set {
+	if s.IsSet() {
 		result := &attrmapper.ResourceSetAttribute{
 			Name: name,
 			SetAttribute: resource.SetAttribute{
@@ -90,6 +97,8 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 			},
 		}
 
+		result.DefaultValue = s.getDefaultValue(name, itemSchema, frameworkdefaults.SetDefaultStaticValue)
+
 		if computability != schema.Computed {
 			result.Validators = s.GetSetValidators()
 		}
@@ -107,6 +116,8 @@ func (s *OASSchema) BuildCollectionResource(name string, computability schema.Co
 		},
 	}
 
+	result.DefaultValue = s.getDefaultValue(name, itemSchema, frameworkdefaults.ListDefaultStaticValue)
+
 	if computability != schema.Computed {
 		result.Validators = s.GetListValidators()
 	}
@@ -120,7 +131,9 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Aliases:           s.SchemaOpts.Aliases,
+		Ignores:           s.SchemaOpts.Ignores,
+		UniqueItemsAsSets: s.SchemaOpts.UniqueItemsAsSets,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -134,7 +147,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 			return nil, s.NestSchemaError(err, name)
 		}
 
-		if s.Format == util.TF_format_set {
+		if s.IsSet() {
 
 			result := &attrmapper.DataSourceSetNestedAttribute{
 				Name: name,
@@ -179,7 +192,7 @@ func (s *OASSchema) BuildCollectionDataSource(name string, computability schema.
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	if s.Format == util.TF_format_set {
+	if s.IsSet() {
 
 		result := &attrmapper.DataSourceSetAttribute{
 			Name: name,
@@ -221,7 +234,9 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Aliases:           s.SchemaOpts.Aliases,
+		Ignores:           s.SchemaOpts.Ignores,
+		UniqueItemsAsSets: s.SchemaOpts.UniqueItemsAsSets,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -235,7 +250,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 			return nil, s.NestSchemaError(err, name)
 		}
 
-		if s.Format == util.TF_format_set {
+		if s.IsSet() {
 
 			result := &attrmapper.ProviderSetNestedAttribute{
 				Name: name,
@@ -274,7 +289,7 @@ func (s *OASSchema) BuildCollectionProvider(name string, optionalOrRequired sche
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	if s.Format == util.TF_format_set {
+	if s.IsSet() {
 		result := &attrmapper.ProviderSetAttribute{
 			Name: name,
 			SetAttribute: provider.SetAttribute{
@@ -309,7 +324,9 @@ func (s *OASSchema) BuildCollectionElementType() (schema.ElementType, *SchemaErr
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Aliases:           s.SchemaOpts.Aliases,
+		Ignores:           s.SchemaOpts.Ignores,
+		UniqueItemsAsSets: s.SchemaOpts.UniqueItemsAsSets,
 	}
 	itemSchema, err := BuildSchema(s.Schema.Items.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -321,7 +338,7 @@ func (s *OASSchema) BuildCollectionElementType() (schema.ElementType, *SchemaErr
 		return schema.ElementType{}, err
 	}
 
-	if s.Format == util.TF_format_set {
+	if s.IsSet() {
 		return schema.ElementType{
 			Set: &schema.SetType{
 				ElementType: elemType,
@@ -362,6 +379,8 @@ func (s *OASSchema) GetListValidators() []schema.ListValidator {
 		})
 	}
 
+	result = append(result, s.getItemsElementValidators().listValidators()...)
+
 	return result
 }
 
@@ -385,5 +404,7 @@ func (s *OASSchema) GetSetValidators() []schema.SetValidator {
 		})
 	}
 
+	result = append(result, s.getItemsElementValidators().setValidators()...)
+
 	return result
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	"gopkg.in/yaml.v3"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
@@ -554,6 +555,107 @@ func TestBuildCollectionResource(t *testing.T) {
 				},
 			},
 		},
+		"list and set attributes default": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"invalid_default_list_prop", "list_prop", "nested_set_prop"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"invalid_default_list_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:    []string{"array"},
+						Default: &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "all"},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"integer"},
+							}),
+						},
+					}),
+					"list_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+						Default: &yaml.Node{
+							Kind: yaml.SequenceNode,
+							Content: []*yaml.Node{
+								{Kind: yaml.ScalarNode, Tag: "!!str", Value: "one"},
+							},
+						},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						},
+					}),
+					"nested_set_prop": base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"array"},
+						Format: "set",
+						Default: &yaml.Node{
+							Kind: yaml.SequenceNode,
+							Content: []*yaml.Node{
+								{
+									Kind: yaml.MappingNode,
+									Content: []*yaml.Node{
+										{Kind: yaml.ScalarNode, Tag: "!!str", Value: "nested_bool"},
+										{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"},
+									},
+								},
+							},
+						},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"nested_bool": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"boolean"},
+									}),
+								}),
+							}),
+						},
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListAttribute{
+					Name: "invalid_default_list_prop",
+					ListAttribute: resource.ListAttribute{
+						ElementType: schema.ElementType{
+							Int64: &schema.Int64Type{},
+						},
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				&attrmapper.ResourceListAttribute{
+					Name: "list_prop",
+					ListAttribute: resource.ListAttribute{
+						ElementType: schema.ElementType{
+							String: &schema.StringType{},
+						},
+						// Required attributes become computed and optional when the default is resolved after merging
+						ComputedOptionalRequired: schema.Required,
+					},
+					DefaultValue: []any{"one"},
+				},
+				&attrmapper.ResourceSetNestedAttribute{
+					Name: "nested_set_prop",
+					NestedObject: attrmapper.ResourceNestedAttributeObject{
+						Attributes: attrmapper.ResourceAttributes{
+							&attrmapper.ResourceBoolAttribute{
+								Name: "nested_bool",
+								BoolAttribute: resource.BoolAttribute{
+									ComputedOptionalRequired: schema.ComputedOptional,
+								},
+							},
+						},
+					},
+					SetNestedAttribute: resource.SetNestedAttribute{
+						// Required attributes become computed and optional when the default is resolved after merging
+						ComputedOptionalRequired: schema.Required,
+					},
+					DefaultValue: []any{
+						map[string]any{
+							"nested_bool": true,
+						},
+					},
+				},
+			},
+		},
 		"list and set attribute - nested map results in element type": {
 			schema: &base.Schema{
 				Type: []string{"object"},
@@ -2104,6 +2206,88 @@ func TestGetListValidators(t *testing.T) {
 				},
 			},
 		},
+		"items-float64": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"array"},
+					Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type:    []string{"number"},
+							Format:  "double",
+							Minimum: pointer(float64(0.5)),
+						}),
+					},
+				},
+			},
+			expected: []schema.ListValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "listvalidator.ValueFloat64sAre(\nfloat64validator.AtLeast(0.5),\n)",
+					},
+				},
+			},
+		},
+		"items-object": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"array"},
+					Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type:          []string{"object"},
+							MinProperties: pointer(int64(1)),
+						}),
+					},
+				},
+			},
+			expected: nil,
+		},
+		"items-string": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:     []string{"array"},
+					MaxItems: pointer(int64(5)),
+					Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type:      []string{"string"},
+							MaxLength: pointer(int64(10)),
+						}),
+					},
+				},
+			},
+			expected: []schema.ListValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+							},
+						},
+						SchemaDefinition: "listvalidator.SizeAtMost(5)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/listvalidator",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+						},
+						SchemaDefinition: "listvalidator.ValueStringsAre(\nstringvalidator.LengthAtMost(10),\n)",
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -2200,6 +2384,35 @@ func TestGetSetValidators(t *testing.T) {
 				},
 			},
 		},
+		"items-int64": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"array"},
+					Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type:    []string{"integer"},
+							Minimum: pointer(float64(1)),
+							Maximum: pointer(float64(10)),
+						}),
+					},
+				},
+			},
+			expected: []schema.SetValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/setvalidator",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "setvalidator.ValueInt64sAre(\nint64validator.Between(1, 10),\n)",
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
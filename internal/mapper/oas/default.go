@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+	"gopkg.in/yaml.v3"
+)
+
+// getDefault returns the `default` of the schema. Schemas without a `default` that have a single allowed value, from `const`
+// or an `enum` with one value, default to that value, as it's the only value the attribute can be set to.
+func (s *OASSchema) getDefault() *yaml.Node {
+	if s.Schema.Default != nil {
+		return s.Schema.Default
+	}
+
+	return s.getConst()
+}
+
+// getDefaultValue returns the default of a collection or object schema decoded into a generic value, which is mapped to a static
+// default when the attribute is converted to the spec. Nested attributes are merged with the other operation schemas before
+// that, so here the default is only checked with staticDefault against the element type of elementSchema, the schema of the
+// collection elements or of the object itself. If the default can't be mapped, nil is returned with a warning logged.
+func (s *OASSchema) getDefaultValue(name string, elementSchema *OASSchema, staticDefault func(elementType schema.ElementType, value any) (*schema.CustomDefault, error)) any {
+	defaultNode := s.getDefault()
+	if defaultNode == nil {
+		return nil
+	}
+
+	var defaultValue any
+	if err := defaultNode.Decode(&defaultValue); err != nil {
+		s.warnOnInvalidDefault(name, err)
+		return nil
+	}
+
+	if defaultValue == nil {
+		return nil
+	}
+
+	elementType, err := elementSchema.BuildElementType()
+	if err != nil {
+		s.warnOnInvalidDefault(name, err)
+		return nil
+	}
+
+	if _, err := staticDefault(elementType, defaultValue); err != nil {
+		s.warnOnInvalidDefault(name, err)
+		return nil
+	}
+
+	return defaultValue
+}
+
+func (s *OASSchema) warnOnInvalidDefault(name string, err error) {
+	s.logger().Warn(
+		"default doesn't match the type of the attribute, the default will be ignored",
+		"attribute", name,
+		"err", err,
+	)
+}
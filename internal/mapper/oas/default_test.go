@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package oas_test
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+)
+
+func TestBuildResourceAttributes_invalidDefaultWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema      *base.Schema
+		expectedLog string
+	}{
+		"valid": {
+			schema: &base.Schema{
+				Type:    []string{"array"},
+				Default: &yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{{Kind: yaml.ScalarNode, Tag: "!!int", Value: "1"}}},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				},
+			},
+			expectedLog: "",
+		},
+		"invalid primitive": {
+			schema: &base.Schema{
+				Type:    []string{"boolean"},
+				Default: &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!int", Value: "1"},
+			},
+			expectedLog: "level=WARN msg=\"default doesn't match the type of the attribute, the default will be ignored\" attribute=prop err=\"yaml: unmarshal errors:\\n  line 0: cannot unmarshal !!int `1` into bool\"\n",
+		},
+		"invalid collection": {
+			schema: &base.Schema{
+				Type:    []string{"array"},
+				Default: &yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{{Kind: yaml.ScalarNode, Tag: "!!str", Value: "one"}}},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				},
+			},
+			expectedLog: "level=WARN msg=\"default doesn't match the type of the attribute, the default will be ignored\" attribute=prop err=\"element 0: expected an integer, got: one\"\n",
+		},
+		"invalid object": {
+			schema: &base.Schema{
+				Type: []string{"object"},
+				Default: &yaml.Node{
+					Kind: yaml.MappingNode,
+					Content: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Tag: "!!str", Value: "unknown"},
+						{Kind: yaml.ScalarNode, Tag: "!!str", Value: "value"},
+					},
+				},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"nested": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			},
+			expectedLog: "level=WARN msg=\"default doesn't match the type of the attribute, the default will be ignored\" attribute=prop err=\"property 'unknown' doesn't match any attribute\"\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}))
+
+			s, err := oas.BuildSchema(base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"prop": base.CreateSchemaProxy(testCase.schema),
+				}),
+			}), oas.SchemaOpts{}, oas.GlobalSchemaOpts{Logger: logger})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err = s.BuildResourceAttributes()
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(logs.String(), testCase.expectedLog); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+)
+
+// elementValidators are the validators for the constraints of a primitive element schema, such as array items or map values.
+// Only the field matching the element type is populated.
+type elementValidators struct {
+	Strings  []*schema.CustomValidator
+	Int64s   []*schema.CustomValidator
+	Float64s []*schema.CustomValidator
+	Numbers  []*schema.CustomValidator
+}
+
+// getElementValidators returns the validators for the constraints of the schema when used as an element type. Object and
+// collection element types aren't validated.
+func (s *OASSchema) getElementValidators() elementValidators {
+	var result elementValidators
+
+	switch s.Type {
+	case util.OAS_type_string:
+		for _, validator := range s.GetStringValidators() {
+			result.Strings = append(result.Strings, validator.Custom)
+		}
+	case util.OAS_type_integer:
+		for _, validator := range s.GetIntegerValidators() {
+			result.Int64s = append(result.Int64s, validator.Custom)
+		}
+	case util.OAS_type_number:
+		if s.Format == util.OAS_format_double || s.Format == util.OAS_format_float {
+			for _, validator := range s.GetFloatValidators() {
+				result.Float64s = append(result.Float64s, validator.Custom)
+			}
+		} else {
+			for _, validator := range s.GetNumberValidators() {
+				result.Numbers = append(result.Numbers, validator.Custom)
+			}
+		}
+	}
+
+	return result
+}
+
+// getItemsElementValidators returns the element validators of the `items` schema. Errors building the `items` schema are
+// ignored, as they are returned when building the element type.
+func (s *OASSchema) getItemsElementValidators() elementValidators {
+	if s.Schema.Items == nil || !s.Schema.Items.IsA() {
+		return elementValidators{}
+	}
+
+	return s.getElementValidatorsFromProxy(s.Schema.Items.A)
+}
+
+// getAdditionalPropertiesElementValidators returns the element validators of the `additionalProperties` schema. Errors
+// building the `additionalProperties` schema are ignored, as they are returned when building the element type.
+func (s *OASSchema) getAdditionalPropertiesElementValidators() elementValidators {
+	if !s.IsMap() {
+		return elementValidators{}
+	}
+
+	return s.getElementValidatorsFromProxy(s.Schema.AdditionalProperties.A)
+}
+
+func (s *OASSchema) getElementValidatorsFromProxy(proxy *base.SchemaProxy) elementValidators {
+	elementSchema, err := BuildSchema(proxy, SchemaOpts{}, s.GlobalSchemaOpts)
+	if err != nil {
+		return elementValidators{}
+	}
+
+	return elementSchema.getElementValidators()
+}
+
+// getPropertyNamesValidators returns the string validators for the constraints of the `propertyNames` schema, which are
+// applied to map keys. Property names are always strings, so the `type` of the `propertyNames` schema is optional.
+func (s *OASSchema) getPropertyNamesValidators() []*schema.CustomValidator {
+	if s.Schema.PropertyNames == nil {
+		return nil
+	}
+
+	propertyNamesSchema, err := buildSchemaProxy(s.Schema.PropertyNames)
+	if err != nil {
+		return nil
+	}
+
+	keySchema := OASSchema{
+		Type:             util.OAS_type_string,
+		Format:           propertyNamesSchema.Format,
+		Schema:           propertyNamesSchema,
+		GlobalSchemaOpts: s.GlobalSchemaOpts,
+	}
+
+	var result []*schema.CustomValidator
+	for _, validator := range keySchema.GetStringValidators() {
+		result = append(result, validator.Custom)
+	}
+
+	return result
+}
+
+func (v elementValidators) listValidators() []schema.ListValidator {
+	var result []schema.ListValidator
+
+	for _, customValidator := range []*schema.CustomValidator{
+		frameworkvalidators.ListValidatorValueStringsAre(v.Strings),
+		frameworkvalidators.ListValidatorValueInt64sAre(v.Int64s),
+		frameworkvalidators.ListValidatorValueFloat64sAre(v.Float64s),
+		frameworkvalidators.ListValidatorValueNumbersAre(v.Numbers),
+	} {
+		if customValidator != nil {
+			result = append(result, schema.ListValidator{
+				Custom: customValidator,
+			})
+		}
+	}
+
+	return result
+}
+
+func (v elementValidators) setValidators() []schema.SetValidator {
+	var result []schema.SetValidator
+
+	for _, customValidator := range []*schema.CustomValidator{
+		frameworkvalidators.SetValidatorValueStringsAre(v.Strings),
+		frameworkvalidators.SetValidatorValueInt64sAre(v.Int64s),
+		frameworkvalidators.SetValidatorValueFloat64sAre(v.Float64s),
+		frameworkvalidators.SetValidatorValueNumbersAre(v.Numbers),
+	} {
+		if customValidator != nil {
+			result = append(result, schema.SetValidator{
+				Custom: customValidator,
+			})
+		}
+	}
+
+	return result
+}
+
+func (v elementValidators) mapValidators() []schema.MapValidator {
+	var result []schema.MapValidator
+
+	for _, customValidator := range []*schema.CustomValidator{
+		frameworkvalidators.MapValidatorValueStringsAre(v.Strings),
+		frameworkvalidators.MapValidatorValueInt64sAre(v.Int64s),
+		frameworkvalidators.MapValidatorValueFloat64sAre(v.Float64s),
+		frameworkvalidators.MapValidatorValueNumbersAre(v.Numbers),
+	} {
+		if customValidator != nil {
+			result = append(result, schema.MapValidator{
+				Custom: customValidator,
+			})
+		}
+	}
+
+	return result
+}
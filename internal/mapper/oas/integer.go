@@ -4,8 +4,12 @@
 package oas
 
 import (
+	"math"
+	"strconv"
+
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
@@ -22,9 +26,9 @@ func (s *OASSchema) BuildIntegerResource(name string, computability schema.Compu
 		},
 	}
 
-	if s.Schema.Default != nil {
+	if defaultNode := s.getDefault(); defaultNode != nil {
 		var staticDefault int64
-		if err := s.Schema.Default.Decode(&staticDefault); err == nil {
+		if err := defaultNode.Decode(&staticDefault); err == nil {
 			if computability == schema.Required {
 				result.ComputedOptionalRequired = schema.ComputedOptional
 			}
@@ -32,10 +36,14 @@ func (s *OASSchema) BuildIntegerResource(name string, computability schema.Compu
 			result.Default = &schema.Int64Default{
 				Static: &staticDefault,
 			}
+		} else {
+			s.warnOnInvalidDefault(name, err)
 		}
 	}
 
 	if computability != schema.Computed {
+		s.warnOnIntegerFormatBounds(name)
+		s.warnOnIntegerMultipleOf(name)
 		result.Validators = s.GetIntegerValidators()
 	}
 
@@ -53,6 +61,8 @@ func (s *OASSchema) BuildIntegerDataSource(name string, computability schema.Com
 	}
 
 	if computability != schema.Computed {
+		s.warnOnIntegerFormatBounds(name)
+		s.warnOnIntegerMultipleOf(name)
 		result.Validators = s.GetIntegerValidators()
 	}
 
@@ -60,6 +70,9 @@ func (s *OASSchema) BuildIntegerDataSource(name string, computability schema.Com
 }
 
 func (s *OASSchema) BuildIntegerProvider(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
+	s.warnOnIntegerFormatBounds(name)
+	s.warnOnIntegerMultipleOf(name)
+
 	result := &attrmapper.ProviderInt64Attribute{
 		Name: name,
 		Int64Attribute: provider.Int64Attribute{
@@ -82,10 +95,10 @@ func (s *OASSchema) BuildIntegerElementType() (schema.ElementType, *SchemaError)
 func (s *OASSchema) GetIntegerValidators() []schema.Int64Validator {
 	var result []schema.Int64Validator
 
-	if len(s.Schema.Enum) > 0 {
+	if len(s.getEnum()) > 0 {
 		var enum []int64
 
-		for _, valueNode := range s.Schema.Enum {
+		for _, valueNode := range s.getEnum() {
 			var value int64
 			if err := valueNode.Decode(&value); err != nil {
 				// could consider error/panic here to notify developers
@@ -104,22 +117,166 @@ func (s *OASSchema) GetIntegerValidators() []schema.Int64Validator {
 		}
 	}
 
-	minimum := s.Schema.Minimum
-	maximum := s.Schema.Maximum
+	minimum, maximum := s.getIntegerBounds()
 
 	if minimum != nil && maximum != nil {
 		result = append(result, schema.Int64Validator{
-			Custom: frameworkvalidators.Int64ValidatorBetween(int64(*minimum), int64(*maximum)),
+			Custom: frameworkvalidators.Int64ValidatorBetween(*minimum, *maximum),
 		})
 	} else if minimum != nil {
 		result = append(result, schema.Int64Validator{
-			Custom: frameworkvalidators.Int64ValidatorAtLeast(int64(*minimum)),
+			Custom: frameworkvalidators.Int64ValidatorAtLeast(*minimum),
 		})
 	} else if maximum != nil {
 		result = append(result, schema.Int64Validator{
-			Custom: frameworkvalidators.Int64ValidatorAtMost(int64(*maximum)),
+			Custom: frameworkvalidators.Int64ValidatorAtMost(*maximum),
+		})
+	}
+
+	if customValidator := s.getIntegerMultipleOfValidator(); customValidator != nil {
+		result = append(result, schema.Int64Validator{
+			Custom: customValidator,
 		})
 	}
 
 	return result
 }
+
+// integerFormatRange is the range of values an integer format can hold.
+type integerFormatRange struct {
+	Minimum int64
+	Maximum int64
+}
+
+// integerFormatRanges are the ranges of integer formats that can't hold every int64 value.
+var integerFormatRanges = map[string]integerFormatRange{
+	util.OAS_format_int32:  {Minimum: math.MinInt32, Maximum: math.MaxInt32},
+	util.OAS_format_uint32: {Minimum: 0, Maximum: math.MaxUint32},
+	util.OAS_format_uint64: {Minimum: 0, Maximum: math.MaxInt64},
+}
+
+// getIntegerBounds returns the lowest and highest integer allowed by the schema, or nil if there is no bound. The bounds are
+// intersected with the range of the integer format, so values the API can't hold aren't allowed.
+func (s *OASSchema) getIntegerBounds() (*int64, *int64) {
+	minimum := s.getIntegerMinimum()
+	maximum := s.getIntegerMaximum()
+
+	formatRange, ok := integerFormatRanges[s.Format]
+	if !ok {
+		return minimum, maximum
+	}
+
+	if minimum == nil || *minimum < formatRange.Minimum {
+		minimum = &formatRange.Minimum
+	}
+
+	// The maximum of int64 is already enforced by the attribute type
+	if (maximum == nil && formatRange.Maximum < math.MaxInt64) || (maximum != nil && *maximum > formatRange.Maximum) {
+		maximum = &formatRange.Maximum
+	}
+
+	return minimum, maximum
+}
+
+// warnOnIntegerFormatBounds logs a warning if the declared bounds of the schema are outside of the range of the integer format.
+func (s *OASSchema) warnOnIntegerFormatBounds(name string) {
+	formatRange, ok := integerFormatRanges[s.Format]
+	if !ok {
+		return
+	}
+
+	outOfRange := func(value *int64) bool {
+		return value != nil && (*value < formatRange.Minimum || *value > formatRange.Maximum)
+	}
+
+	minimum := s.getIntegerMinimum()
+	maximum := s.getIntegerMaximum()
+
+	if outOfRange(minimum) || outOfRange(maximum) {
+		s.logger().Warn(
+			"integer bounds are outside of the range of the format, the bounds will be limited to the range of the format",
+			"attribute", name,
+			"format", s.Format,
+			"format_minimum", formatRange.Minimum,
+			"format_maximum", formatRange.Maximum,
+		)
+	}
+}
+
+// getIntegerMinimum returns the lowest integer allowed by the lower bound of the schema, or nil if there is none. Fractional
+// bounds are rounded up, and exclusive bounds are raised to the next integer, so no integer below the bound is allowed.
+func (s *OASSchema) getIntegerMinimum() *int64 {
+	bound := s.getMinimum()
+	if bound == nil {
+		return nil
+	}
+
+	value := math.Ceil(bound.Value)
+	if bound.Exclusive && value == bound.Value {
+		value++
+	}
+
+	minimum := clampToInt64(value)
+	return &minimum
+}
+
+// getIntegerMaximum returns the highest integer allowed by the upper bound of the schema, or nil if there is none. Fractional
+// bounds are rounded down, and exclusive bounds are lowered to the previous integer, so no integer above the bound is allowed.
+func (s *OASSchema) getIntegerMaximum() *int64 {
+	bound := s.getMaximum()
+	if bound == nil {
+		return nil
+	}
+
+	value := math.Floor(bound.Value)
+	if bound.Exclusive && value == bound.Value {
+		value--
+	}
+
+	maximum := clampToInt64(value)
+	return &maximum
+}
+
+// getIntegerMultipleOf returns the `multipleOf` of the schema, or nil if it isn't an integer in the range of int64.
+func (s *OASSchema) getIntegerMultipleOf() *int64 {
+	multipleOf := s.Schema.MultipleOf
+	if multipleOf == nil || *multipleOf != math.Trunc(*multipleOf) || *multipleOf >= float64(math.MaxInt64) {
+		return nil
+	}
+
+	result := int64(*multipleOf)
+	return &result
+}
+
+// getIntegerMultipleOfValidator returns a validator calling the configured int64 multipleOf validator function with the
+// `multipleOf` of the schema, or nil if there is no function or the `multipleOf` isn't an integer.
+func (s *OASSchema) getIntegerMultipleOfValidator() *schema.CustomValidator {
+	function := s.GlobalSchemaOpts.MultipleOfValidators.Int64
+	multipleOf := s.getIntegerMultipleOf()
+
+	if function == nil || multipleOf == nil {
+		return nil
+	}
+
+	return function.customValidator(strconv.FormatInt(*multipleOf, 10))
+}
+
+// warnOnIntegerMultipleOf logs a warning if the `multipleOf` of the schema isn't validated.
+func (s *OASSchema) warnOnIntegerMultipleOf(name string) {
+	s.warnOnMultipleOf(name, s.GlobalSchemaOpts.MultipleOfValidators.Int64, s.getIntegerMultipleOfValidator())
+}
+
+// clampToInt64 converts an integral value to int64, limited to the range of int64. Converting a float64 outside of the range
+// of int64 doesn't panic, but the result is implementation-specific.
+func clampToInt64(value float64) int64 {
+	// float64(math.MaxInt64) is rounded up to 2^63, which is outside of the range of int64
+	if value >= float64(math.MaxInt64) {
+		return math.MaxInt64
+	}
+
+	if value <= float64(math.MinInt64) {
+		return math.MinInt64
+	}
+
+	return int64(value)
+}
@@ -4,6 +4,8 @@
 package oas_test
 
 import (
+	"bytes"
+	"log/slog"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
@@ -595,6 +597,139 @@ func TestGetIntegerValidators(t *testing.T) {
 				},
 			},
 		},
+		"const": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:  []string{"integer"},
+					Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "2"},
+					Enum: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Value: "1"},
+						{Kind: yaml.ScalarNode, Value: "2"},
+					},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.OneOf(\n2,\n)",
+					},
+				},
+			},
+		},
+		"format-int32": {
+			schema: oas.OASSchema{
+				Format: "int32",
+				Schema: &base.Schema{
+					Type:   []string{"integer"},
+					Format: "int32",
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(-2147483648, 2147483647)",
+					},
+				},
+			},
+		},
+		"format-int32-maximum-out-of-range": {
+			schema: oas.OASSchema{
+				Format: "int32",
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Format:  "int32",
+					Minimum: pointer(float64(1)),
+					Maximum: pointer(float64(1e10)),
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(1, 2147483647)",
+					},
+				},
+			},
+		},
+		"format-int32-minimum": {
+			schema: oas.OASSchema{
+				Format: "int32",
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Format:  "int32",
+					Minimum: pointer(float64(0)),
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(0, 2147483647)",
+					},
+				},
+			},
+		},
+		"format-uint32-maximum": {
+			schema: oas.OASSchema{
+				Format: "uint32",
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Format:  "uint32",
+					Maximum: pointer(float64(10)),
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(0, 10)",
+					},
+				},
+			},
+		},
+		"format-uint64": {
+			schema: oas.OASSchema{
+				Format: "uint64",
+				Schema: &base.Schema{
+					Type:   []string{"integer"},
+					Format: "uint64",
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.AtLeast(0)",
+					},
+				},
+			},
+		},
 		"maximum": {
 			schema: oas.OASSchema{
 				Schema: &base.Schema{
@@ -615,6 +750,109 @@ func TestGetIntegerValidators(t *testing.T) {
 				},
 			},
 		},
+		"exclusive-maximum-oas-3.0": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"integer"},
+					Maximum:          pointer(float64(100)),
+					ExclusiveMaximum: &base.DynamicValue[bool, float64]{N: 0, A: true},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.AtMost(99)",
+					},
+				},
+			},
+		},
+		"exclusive-maximum-oas-3.1-fractional": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"integer"},
+					ExclusiveMaximum: &base.DynamicValue[bool, float64]{N: 1, B: 99.5},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.AtMost(99)",
+					},
+				},
+			},
+		},
+		"exclusive-minimum-oas-3.0": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"integer"},
+					Minimum:          pointer(float64(0)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 0, A: true},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.AtLeast(1)",
+					},
+				},
+			},
+		},
+		"exclusive-minimum-oas-3.1": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"integer"},
+					Minimum:          pointer(float64(-10)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 1, B: 0},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.AtLeast(1)",
+					},
+				},
+			},
+		},
+		"maximum-fractional-negative": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Maximum: pointer(float64(-1.5)),
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.AtMost(-2)",
+					},
+				},
+			},
+		},
 		"maximum-and-minimum": {
 			schema: oas.OASSchema{
 				Schema: &base.Schema{
@@ -631,7 +869,7 @@ func TestGetIntegerValidators(t *testing.T) {
 								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
 							},
 						},
-						SchemaDefinition: "int64validator.Between(123, 456)",
+						SchemaDefinition: "int64validator.Between(124, 456)",
 					},
 				},
 			},
@@ -656,6 +894,199 @@ func TestGetIntegerValidators(t *testing.T) {
 				},
 			},
 		},
+		"minimum-fractional-negative": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Minimum: pointer(float64(-1.5)),
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.AtLeast(-1)",
+					},
+				},
+			},
+		},
+		"bounds-outside-int64": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"integer"},
+					Minimum: pointer(float64(-1e20)),
+					Maximum: pointer(float64(1e20)),
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(-9223372036854775808, 9223372036854775807)",
+					},
+				},
+			},
+		},
+		"multiple-of": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"integer"},
+					Minimum:    pointer(float64(1)),
+					Maximum:    pointer(float64(32)),
+					MultipleOf: pointer(float64(8)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					MultipleOfValidators: oas.MultipleOfValidators{
+						Int64: &oas.ValidatorFunction{
+							Import: &code.Import{
+								Path: "github.com/example/validators",
+							},
+							Function: "validators.Int64MultipleOf",
+						},
+					},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(1, 32)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/example/validators",
+							},
+						},
+						SchemaDefinition: "validators.Int64MultipleOf(8)",
+					},
+				},
+			},
+		},
+		"multiple-of-unbounded": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"integer"},
+					MultipleOf: pointer(float64(8)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					MultipleOfValidators: oas.MultipleOfValidators{
+						Int64: &oas.ValidatorFunction{
+							Function: "Int64MultipleOf",
+						},
+					},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "Int64MultipleOf(8)",
+					},
+				},
+			},
+		},
+		"multiple-of-large-range": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"integer"},
+					Minimum:    pointer(float64(0)),
+					Maximum:    pointer(float64(1e12)),
+					MultipleOf: pointer(float64(3)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					MultipleOfValidators: oas.MultipleOfValidators{
+						Int64: &oas.ValidatorFunction{
+							Function: "Int64MultipleOf",
+						},
+					},
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(0, 1000000000000)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "Int64MultipleOf(3)",
+					},
+				},
+			},
+		},
+		"multiple-of-without-function": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"integer"},
+					Minimum:    pointer(float64(1)),
+					Maximum:    pointer(float64(32)),
+					MultipleOf: pointer(float64(8)),
+				},
+			},
+			expected: []schema.Int64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/int64validator",
+							},
+						},
+						SchemaDefinition: "int64validator.Between(1, 32)",
+					},
+				},
+			},
+		},
+		"multiple-of-fractional": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"integer"},
+					MultipleOf: pointer(float64(2.5)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					MultipleOfValidators: oas.MultipleOfValidators{
+						Int64: &oas.ValidatorFunction{
+							Function: "Int64MultipleOf",
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		"multiple-of-outside-int64": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"integer"},
+					MultipleOf: pointer(float64(1e20)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					MultipleOfValidators: oas.MultipleOfValidators{
+						Int64: &oas.ValidatorFunction{
+							Function: "Int64MultipleOf",
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -671,3 +1102,152 @@ func TestGetIntegerValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildIntegerResource_formatBoundsWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema      *base.Schema
+		expectedLog string
+	}{
+		"in-range": {
+			schema: &base.Schema{
+				Type:    []string{"integer"},
+				Format:  "int32",
+				Minimum: pointer(float64(0)),
+				Maximum: pointer(float64(65535)),
+			},
+			expectedLog: "",
+		},
+		"out-of-range": {
+			schema: &base.Schema{
+				Type:    []string{"integer"},
+				Format:  "uint32",
+				Minimum: pointer(float64(-1)),
+			},
+			expectedLog: "level=WARN msg=\"integer bounds are outside of the range of the format, the bounds will be limited to the range of the format\" attribute=int_prop format=uint32 format_minimum=0 format_maximum=4294967295\n",
+		},
+		"no-format": {
+			schema: &base.Schema{
+				Type:    []string{"integer"},
+				Minimum: pointer(float64(-1e12)),
+			},
+			expectedLog: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}))
+
+			s, err := oas.BuildSchema(base.CreateSchemaProxy(testCase.schema), oas.SchemaOpts{}, oas.GlobalSchemaOpts{Logger: logger})
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err = s.BuildIntegerResource("int_prop", schema.Optional)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(logs.String(), testCase.expectedLog); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildIntegerResource_multipleOfWarning(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema               *base.Schema
+		multipleOfValidators oas.MultipleOfValidators
+		expectedLog          string
+	}{
+		"validated": {
+			schema: &base.Schema{
+				Type:       []string{"integer"},
+				MultipleOf: pointer(float64(8)),
+			},
+			multipleOfValidators: oas.MultipleOfValidators{
+				Int64: &oas.ValidatorFunction{
+					Function: "Int64MultipleOf",
+				},
+			},
+			expectedLog: "",
+		},
+		"no-function": {
+			schema: &base.Schema{
+				Type:       []string{"integer"},
+				MultipleOf: pointer(float64(8)),
+			},
+			expectedLog: "level=WARN msg=\"multipleOf can only be validated with a function from multiple_of_validators in the generator config, the multiples won't be validated\" attribute=int_prop multiple_of=8\n",
+		},
+		"fractional": {
+			schema: &base.Schema{
+				Type:       []string{"integer"},
+				MultipleOf: pointer(float64(2.5)),
+			},
+			multipleOfValidators: oas.MultipleOfValidators{
+				Int64: &oas.ValidatorFunction{
+					Function: "Int64MultipleOf",
+				},
+			},
+			expectedLog: "level=WARN msg=\"multipleOf isn't supported for the attribute type, the multiples won't be validated\" attribute=int_prop multiple_of=2.5\n",
+		},
+		"no-multiple-of": {
+			schema: &base.Schema{
+				Type: []string{"integer"},
+			},
+			expectedLog: "",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}))
+
+			globalSchemaOpts := oas.GlobalSchemaOpts{
+				MultipleOfValidators: testCase.multipleOfValidators,
+				Logger:               logger,
+			}
+
+			s, err := oas.BuildSchema(base.CreateSchemaProxy(testCase.schema), oas.SchemaOpts{}, globalSchemaOpts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err = s.BuildIntegerResource("int_prop", schema.Optional)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(logs.String(), testCase.expectedLog); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
@@ -23,7 +24,9 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Aliases:           s.SchemaOpts.Aliases,
+		Ignores:           s.SchemaOpts.Ignores,
+		UniqueItemsAsSets: s.SchemaOpts.UniqueItemsAsSets,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -35,6 +38,7 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 		if err != nil {
 			return nil, s.NestSchemaError(err, name)
 		}
+
 		result := &attrmapper.ResourceMapNestedAttribute{
 			Name: name,
 			NestedObject: attrmapper.ResourceNestedAttributeObject{
@@ -47,6 +51,8 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 			},
 		}
 
+		result.DefaultValue = s.getDefaultValue(name, mapSchema, frameworkdefaults.MapDefaultStaticValue)
+
 		if computability != schema.Computed {
 			result.Validators = s.GetMapValidators()
 		}
@@ -69,6 +75,8 @@ func (s *OASSchema) BuildMapResource(name string, computability schema.ComputedO
 		},
 	}
 
+	result.DefaultValue = s.getDefaultValue(name, mapSchema, frameworkdefaults.MapDefaultStaticValue)
+
 	if computability != schema.Computed {
 		result.Validators = s.GetMapValidators()
 	}
@@ -84,7 +92,9 @@ func (s *OASSchema) BuildMapDataSource(name string, computability schema.Compute
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Aliases:           s.SchemaOpts.Aliases,
+		Ignores:           s.SchemaOpts.Ignores,
+		UniqueItemsAsSets: s.SchemaOpts.UniqueItemsAsSets,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -146,7 +156,9 @@ func (s *OASSchema) BuildMapProvider(name string, optionalOrRequired schema.Opti
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Aliases:           s.SchemaOpts.Aliases,
+		Ignores:           s.SchemaOpts.Ignores,
+		UniqueItemsAsSets: s.SchemaOpts.UniqueItemsAsSets,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -202,7 +214,9 @@ func (s *OASSchema) BuildMapElementType() (schema.ElementType, *SchemaError) {
 	}
 
 	schemaOpts := SchemaOpts{
-		Ignores: s.SchemaOpts.Ignores,
+		Aliases:           s.SchemaOpts.Aliases,
+		Ignores:           s.SchemaOpts.Ignores,
+		UniqueItemsAsSets: s.SchemaOpts.UniqueItemsAsSets,
 	}
 	mapSchema, err := BuildSchema(s.Schema.AdditionalProperties.A, schemaOpts, s.GlobalSchemaOpts)
 	if err != nil {
@@ -241,5 +255,13 @@ func (s *OASSchema) GetMapValidators() []schema.MapValidator {
 		})
 	}
 
+	if keysValidator := frameworkvalidators.MapValidatorKeysAre(s.getPropertyNamesValidators()); keysValidator != nil {
+		result = append(result, schema.MapValidator{
+			Custom: keysValidator,
+		})
+	}
+
+	result = append(result, s.getAdditionalPropertiesElementValidators().mapValidators()...)
+
 	return result
 }
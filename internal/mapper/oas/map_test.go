@@ -870,6 +870,68 @@ func TestGetMapValidators(t *testing.T) {
 				},
 			},
 		},
+		"additionalProperties-string": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"object"},
+					AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type:    []string{"string"},
+							Pattern: "^[a-z]+$",
+						}),
+					},
+				},
+			},
+			expected: []schema.MapValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+							{
+								Path: "regexp",
+							},
+						},
+						SchemaDefinition: "mapvalidator.ValueStringsAre(\nstringvalidator.RegexMatches(regexp.MustCompile(\"^[a-z]+$\"), \"\"),\n)",
+					},
+				},
+			},
+		},
+		"propertyNames": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"object"},
+					PropertyNames: base.CreateSchemaProxy(&base.Schema{
+						MinLength: pointer(int64(1)),
+						MaxLength: pointer(int64(63)),
+					}),
+					AdditionalProperties: &base.DynamicValue[*base.SchemaProxy, bool]{
+						A: base.CreateSchemaProxy(&base.Schema{
+							Type: []string{"integer"},
+						}),
+					},
+				},
+			},
+			expected: []schema.MapValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+						},
+						SchemaDefinition: "mapvalidator.KeysAre(\nstringvalidator.LengthBetween(1, 63),\n)",
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -0,0 +1,67 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+)
+
+// MultipleOfValidators are validator functions, defined by the provider, that `multipleOf` is mapped to for each attribute type.
+// The framework validators module has no validators for multiples, so `multipleOf` isn't validated without a function.
+type MultipleOfValidators struct {
+	// Int64 is called with the `multipleOf` of integer schemas.
+	Int64 *ValidatorFunction
+
+	// Float64 is called with the `multipleOf` of number schemas with a `double` or `float` format.
+	Float64 *ValidatorFunction
+
+	// Number is called with the `multipleOf` of other number schemas.
+	Number *ValidatorFunction
+}
+
+// ValidatorFunction is a Go function that returns a validator, called with the value of a schema keyword.
+type ValidatorFunction struct {
+	// Import is the package that defines the function, or nil if it's defined in the package of the generated code.
+	Import *code.Import
+
+	// Function is the qualified name of the function, such as `validators.Int64MultipleOf`.
+	Function string
+}
+
+// customValidator returns a custom validator that calls the function with the argument.
+func (f *ValidatorFunction) customValidator(argument string) *schema.CustomValidator {
+	customValidator := &schema.CustomValidator{
+		SchemaDefinition: f.Function + "(" + argument + ")",
+	}
+
+	if f.Import != nil {
+		customValidator.Imports = []code.Import{*f.Import}
+	}
+
+	return customValidator
+}
+
+// warnOnMultipleOf logs a warning if the schema has a `multipleOf` that isn't mapped to a validator, as the validator function
+// for the attribute type isn't configured or the value isn't supported by the attribute type.
+func (s *OASSchema) warnOnMultipleOf(name string, function *ValidatorFunction, validator *schema.CustomValidator) {
+	if s.Schema.MultipleOf == nil || validator != nil {
+		return
+	}
+
+	if function == nil {
+		s.logger().Warn(
+			"multipleOf can only be validated with a function from multiple_of_validators in the generator config, the multiples won't be validated",
+			"attribute", name,
+			"multiple_of", *s.Schema.MultipleOf,
+		)
+		return
+	}
+
+	s.logger().Warn(
+		"multipleOf isn't supported for the attribute type, the multiples won't be validated",
+		"attribute", name,
+		"multiple_of", *s.Schema.MultipleOf,
+	)
+}
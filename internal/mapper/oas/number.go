@@ -4,7 +4,10 @@
 package oas
 
 import (
+	"strconv"
+
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
@@ -24,9 +27,9 @@ func (s *OASSchema) BuildNumberResource(name string, computability schema.Comput
 			},
 		}
 
-		if s.Schema.Default != nil {
+		if defaultNode := s.getDefault(); defaultNode != nil {
 			var staticDefault float64
-			if err := s.Schema.Default.Decode(&staticDefault); err == nil {
+			if err := defaultNode.Decode(&staticDefault); err == nil {
 				if computability == schema.Required {
 					result.ComputedOptionalRequired = schema.ComputedOptional
 				}
@@ -34,24 +37,50 @@ func (s *OASSchema) BuildNumberResource(name string, computability schema.Comput
 				result.Default = &schema.Float64Default{
 					Static: &staticDefault,
 				}
+			} else {
+				s.warnOnInvalidDefault(name, err)
 			}
 		}
 
 		if computability != schema.Computed {
+			s.warnOnFloatMultipleOf(name)
 			result.Validators = s.GetFloatValidators()
 		}
 
 		return result, nil
 	}
 
-	return &attrmapper.ResourceNumberAttribute{
+	result := &attrmapper.ResourceNumberAttribute{
 		Name: name,
 		NumberAttribute: resource.NumberAttribute{
 			ComputedOptionalRequired: computability,
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
 		},
-	}, nil
+	}
+
+	if defaultNode := s.getDefault(); defaultNode != nil {
+		var staticDefault float64
+		if err := defaultNode.Decode(&staticDefault); err == nil {
+			if computability == schema.Required {
+				result.ComputedOptionalRequired = schema.ComputedOptional
+			}
+
+			result.Default = &schema.NumberDefault{
+				Custom: frameworkdefaults.NumberDefaultStaticBigFloat(staticDefault),
+			}
+		} else {
+			s.warnOnInvalidDefault(name, err)
+		}
+	}
+
+	if computability != schema.Computed {
+		s.warnOnNumberRange(name)
+		s.warnOnNumberMultipleOf(name)
+		result.Validators = s.GetNumberValidators()
+	}
+
+	return result, nil
 }
 
 func (s *OASSchema) BuildNumberDataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
@@ -66,6 +95,7 @@ func (s *OASSchema) BuildNumberDataSource(name string, computability schema.Comp
 		}
 
 		if computability != schema.Computed {
+			s.warnOnFloatMultipleOf(name)
 			result.Validators = s.GetFloatValidators()
 		}
 
@@ -80,11 +110,19 @@ func (s *OASSchema) BuildNumberDataSource(name string, computability schema.Comp
 		},
 	}
 
+	if computability != schema.Computed {
+		s.warnOnNumberRange(name)
+		s.warnOnNumberMultipleOf(name)
+		result.Validators = s.GetNumberValidators()
+	}
+
 	return result, nil
 }
 
 func (s *OASSchema) BuildNumberProvider(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
 	if s.Format == util.OAS_format_double || s.Format == util.OAS_format_float {
+		s.warnOnFloatMultipleOf(name)
+
 		result := &attrmapper.ProviderFloat64Attribute{
 			Name: name,
 			Float64Attribute: provider.Float64Attribute{
@@ -97,12 +135,16 @@ func (s *OASSchema) BuildNumberProvider(name string, optionalOrRequired schema.O
 
 		return result, nil
 	}
+	s.warnOnNumberRange(name)
+	s.warnOnNumberMultipleOf(name)
+
 	result := &attrmapper.ProviderNumberAttribute{
 		Name: name,
 		NumberAttribute: provider.NumberAttribute{
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Validators:         s.GetNumberValidators(),
 		},
 	}
 
@@ -124,27 +166,172 @@ func (s *OASSchema) BuildNumberElementType() (schema.ElementType, *SchemaError)
 func (s *OASSchema) GetFloatValidators() []schema.Float64Validator {
 	var result []schema.Float64Validator
 
-	if len(s.Schema.Enum) > 0 {
-		var enum []float64
+	if enum := s.getFloatEnum(); len(enum) > 0 {
+		result = append(result, schema.Float64Validator{
+			Custom: frameworkvalidators.Float64ValidatorOneOf(enum),
+		})
+	}
 
-		for _, valueNode := range s.Schema.Enum {
-			var value float64
-			if err := valueNode.Decode(&value); err != nil {
-				// could consider error/panic here to notify developers
-				continue
-			}
+	minimum := s.getMinimum()
+	maximum := s.getMaximum()
 
-			enum = append(enum, value)
+	if minimum != nil && maximum != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: frameworkvalidators.Float64ValidatorBetween(minimum.Value, maximum.Value),
+		})
+	} else if minimum != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: frameworkvalidators.Float64ValidatorAtLeast(minimum.Value),
+		})
+	} else if maximum != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: frameworkvalidators.Float64ValidatorAtMost(maximum.Value),
+		})
+	}
+
+	// There are no exclusive range validators in the framework validators module, so exclusive bounds are mapped to the
+	// inclusive range validator above, with the bound values themselves disallowed.
+	var exclusiveBounds []float64
+	if minimum != nil && minimum.Exclusive {
+		exclusiveBounds = append(exclusiveBounds, minimum.Value)
+	}
+	if maximum != nil && maximum.Exclusive {
+		exclusiveBounds = append(exclusiveBounds, maximum.Value)
+	}
+
+	if customValidator := frameworkvalidators.Float64ValidatorNoneOf(exclusiveBounds); customValidator != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: customValidator,
+		})
+	}
+
+	if customValidator := s.getFloatMultipleOfValidator(s.GlobalSchemaOpts.MultipleOfValidators.Float64); customValidator != nil {
+		result = append(result, schema.Float64Validator{
+			Custom: customValidator,
+		})
+	}
+
+	return result
+}
+
+// GetNumberValidators returns the validators for arbitrary-precision number attributes.
+func (s *OASSchema) GetNumberValidators() []schema.NumberValidator {
+	var result []schema.NumberValidator
+
+	if enum := s.getFloatEnum(); len(enum) > 0 {
+		result = append(result, schema.NumberValidator{
+			Custom: frameworkvalidators.NumberValidatorOneOf(enum),
+		})
+	}
+
+	if customValidator := s.getFloatMultipleOfValidator(s.GlobalSchemaOpts.MultipleOfValidators.Number); customValidator != nil {
+		result = append(result, schema.NumberValidator{
+			Custom: customValidator,
+		})
+	}
+
+	return result
+}
+
+// getFloatMultipleOfValidator returns a validator calling the multipleOf validator function with the `multipleOf` of the
+// schema, or nil if there is no function or `multipleOf`.
+func (s *OASSchema) getFloatMultipleOfValidator(function *ValidatorFunction) *schema.CustomValidator {
+	if function == nil || s.Schema.MultipleOf == nil {
+		return nil
+	}
+
+	return function.customValidator(strconv.FormatFloat(*s.Schema.MultipleOf, 'f', -1, 64))
+}
+
+// warnOnNumberRange logs a warning if an arbitrary-precision number schema has bounds, which the framework validators module
+// has no validators for.
+func (s *OASSchema) warnOnNumberRange(name string) {
+	if s.getMinimum() == nil && s.getMaximum() == nil {
+		return
+	}
+
+	s.logger().Warn(
+		"number bounds can't be validated for number attributes without a double or float format, the bounds won't be validated",
+		"attribute", name,
+	)
+}
+
+// warnOnFloatMultipleOf logs a warning if the `multipleOf` of a float64 schema isn't validated.
+func (s *OASSchema) warnOnFloatMultipleOf(name string) {
+	function := s.GlobalSchemaOpts.MultipleOfValidators.Float64
+	s.warnOnMultipleOf(name, function, s.getFloatMultipleOfValidator(function))
+}
+
+// warnOnNumberMultipleOf logs a warning if the `multipleOf` of an arbitrary-precision number schema isn't validated.
+func (s *OASSchema) warnOnNumberMultipleOf(name string) {
+	function := s.GlobalSchemaOpts.MultipleOfValidators.Number
+	s.warnOnMultipleOf(name, function, s.getFloatMultipleOfValidator(function))
+}
+
+func (s *OASSchema) getFloatEnum() []float64 {
+	var enum []float64
+
+	for _, valueNode := range s.getEnum() {
+		var value float64
+		if err := valueNode.Decode(&value); err != nil {
+			// could consider error/panic here to notify developers
+			continue
+		}
+
+		enum = append(enum, value)
+	}
+
+	return enum
+}
+
+// numericBound is a lower or upper bound of a number or integer schema.
+type numericBound struct {
+	Value     float64
+	Exclusive bool
+}
+
+// getMinimum returns the lower bound of the schema, or nil if there is none. In OAS 3.0, `exclusiveMinimum` is a boolean that
+// makes `minimum` exclusive. In OAS 3.1, `exclusiveMinimum` is a number of its own, and the more restrictive of it and
+// `minimum` is returned.
+func (s *OASSchema) getMinimum() *numericBound {
+	var bound *numericBound
+
+	if s.Schema.Minimum != nil {
+		bound = &numericBound{Value: *s.Schema.Minimum}
+
+		if s.Schema.ExclusiveMinimum != nil && s.Schema.ExclusiveMinimum.IsA() {
+			bound.Exclusive = s.Schema.ExclusiveMinimum.A
+		}
+	}
+
+	if s.Schema.ExclusiveMinimum != nil && s.Schema.ExclusiveMinimum.IsB() {
+		if bound == nil || s.Schema.ExclusiveMinimum.B >= bound.Value {
+			bound = &numericBound{Value: s.Schema.ExclusiveMinimum.B, Exclusive: true}
 		}
+	}
+
+	return bound
+}
+
+// getMaximum returns the upper bound of the schema, or nil if there is none. In OAS 3.0, `exclusiveMaximum` is a boolean that
+// makes `maximum` exclusive. In OAS 3.1, `exclusiveMaximum` is a number of its own, and the more restrictive of it and
+// `maximum` is returned.
+func (s *OASSchema) getMaximum() *numericBound {
+	var bound *numericBound
 
-		customValidator := frameworkvalidators.Float64ValidatorOneOf(enum)
+	if s.Schema.Maximum != nil {
+		bound = &numericBound{Value: *s.Schema.Maximum}
 
-		if customValidator != nil {
-			result = append(result, schema.Float64Validator{
-				Custom: customValidator,
-			})
+		if s.Schema.ExclusiveMaximum != nil && s.Schema.ExclusiveMaximum.IsA() {
+			bound.Exclusive = s.Schema.ExclusiveMaximum.A
 		}
 	}
 
-	return result
+	if s.Schema.ExclusiveMaximum != nil && s.Schema.ExclusiveMaximum.IsB() {
+		if bound == nil || s.Schema.ExclusiveMaximum.B <= bound.Value {
+			bound = &numericBound{Value: s.Schema.ExclusiveMaximum.B, Exclusive: true}
+		}
+	}
+
+	return bound
 }
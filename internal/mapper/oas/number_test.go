@@ -4,6 +4,8 @@
 package oas_test
 
 import (
+	"bytes"
+	"log/slog"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
@@ -139,6 +141,63 @@ func TestBuildNumberResource(t *testing.T) {
 				},
 			},
 		},
+		"number attributes default": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"number_prop_required_default"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"number_prop_default": base.CreateSchemaProxy(&base.Schema{
+						Type:    []string{"number"},
+						Default: &yaml.Node{Kind: yaml.ScalarNode, Value: "123.45"},
+					}),
+					"number_prop_required_default": base.CreateSchemaProxy(&base.Schema{
+						Type:    []string{"number"},
+						Default: &yaml.Node{Kind: yaml.ScalarNode, Value: "10"},
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceNumberAttribute{
+					Name: "number_prop_default",
+					NumberAttribute: resource.NumberAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.NumberDefault{
+							Custom: &schema.CustomDefault{
+								Imports: []code.Import{
+									{
+										Path: "math/big",
+									},
+									{
+										Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/numberdefault",
+									},
+								},
+								SchemaDefinition: "numberdefault.StaticBigFloat(big.NewFloat(123.45))",
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceNumberAttribute{
+					Name: "number_prop_required_default",
+					NumberAttribute: resource.NumberAttribute{
+						// Intentionally not required due to default
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.NumberDefault{
+							Custom: &schema.CustomDefault{
+								Imports: []code.Import{
+									{
+										Path: "math/big",
+									},
+									{
+										Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/numberdefault",
+									},
+								},
+								SchemaDefinition: "numberdefault.StaticBigFloat(big.NewFloat(10))",
+							},
+						},
+					},
+				},
+			},
+		},
 		"float64 attributes deprecated": {
 			schema: &base.Schema{
 				Type: []string{"object"},
@@ -1108,6 +1167,283 @@ func TestGetFloatValidators(t *testing.T) {
 				},
 			},
 		},
+		"exclusive-maximum-oas-3.1": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"number"},
+					Format:           "double",
+					ExclusiveMaximum: &base.DynamicValue[bool, float64]{N: 1, B: 100},
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.AtMost(100)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.NoneOf(\n100,\n)",
+					},
+				},
+			},
+		},
+		"exclusive-minimum-and-maximum-oas-3.0": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"number"},
+					Format:           "double",
+					Minimum:          pointer(float64(0)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 0, A: true},
+					Maximum:          pointer(float64(1)),
+					ExclusiveMaximum: &base.DynamicValue[bool, float64]{N: 0, A: true},
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.Between(0, 1)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.NoneOf(\n0,\n1,\n)",
+					},
+				},
+			},
+		},
+		"exclusive-minimum-oas-3.0-false": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"number"},
+					Format:           "double",
+					Minimum:          pointer(float64(0.5)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 0, A: false},
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.AtLeast(0.5)",
+					},
+				},
+			},
+		},
+		"exclusive-minimum-oas-3.1": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"number"},
+					Format:           "double",
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 1, B: 0},
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.AtLeast(0)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.NoneOf(\n0,\n)",
+					},
+				},
+			},
+		},
+		"exclusive-minimum-oas-3.1-less-restrictive": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"number"},
+					Format:           "double",
+					Minimum:          pointer(float64(10)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 1, B: 5},
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.AtLeast(10)",
+					},
+				},
+			},
+		},
+		"exclusive-minimum-oas-3.1-more-restrictive": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:             []string{"number"},
+					Format:           "double",
+					Minimum:          pointer(float64(10)),
+					ExclusiveMinimum: &base.DynamicValue[bool, float64]{N: 1, B: 10},
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.AtLeast(10)",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.NoneOf(\n10,\n)",
+					},
+				},
+			},
+		},
+		"maximum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Format:  "double",
+					Maximum: pointer(float64(123.4)),
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.AtMost(123.4)",
+					},
+				},
+			},
+		},
+		"maximum-and-minimum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Format:  "double",
+					Minimum: pointer(float64(-1.5)),
+					Maximum: pointer(float64(1.5)),
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.Between(-1.5, 1.5)",
+					},
+				},
+			},
+		},
+		"minimum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Format:  "double",
+					Minimum: pointer(float64(0.1)),
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/float64validator",
+							},
+						},
+						SchemaDefinition: "float64validator.AtLeast(0.1)",
+					},
+				},
+			},
+		},
+		"multipleOf": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"number"},
+					Format:     "double",
+					MultipleOf: pointer(float64(0.01)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					MultipleOfValidators: oas.MultipleOfValidators{
+						Float64: &oas.ValidatorFunction{
+							Import: &code.Import{
+								Path: "github.com/example/validators",
+							},
+							Function: "validators.Float64MultipleOf",
+						},
+					},
+				},
+			},
+			expected: []schema.Float64Validator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/example/validators",
+							},
+						},
+						SchemaDefinition: "validators.Float64MultipleOf(0.01)",
+					},
+				},
+			},
+		},
+		"multipleOf-without-function": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"number"},
+					Format:     "double",
+					MultipleOf: pointer(float64(0.01)),
+				},
+			},
+			expected: nil,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -1123,3 +1459,228 @@ func TestGetFloatValidators(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNumberValidators(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema   oas.OASSchema
+		expected []schema.NumberValidator
+	}{
+		"none": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"number"},
+				},
+			},
+			expected: nil,
+		},
+		"enum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"number"},
+					Enum: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Value: "1.2"},
+						{Kind: yaml.ScalarNode, Value: "2.3"},
+					},
+				},
+			},
+			expected: []schema.NumberValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "math/big",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/numbervalidator",
+							},
+						},
+						SchemaDefinition: "numbervalidator.OneOf(\nbig.NewFloat(1.2),\nbig.NewFloat(2.3),\n)",
+					},
+				},
+			},
+		},
+		"minimum-unsupported": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:    []string{"number"},
+					Minimum: pointer(float64(1)),
+				},
+			},
+			expected: nil,
+		},
+		"multipleOf": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"number"},
+					MultipleOf: pointer(float64(5)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					MultipleOfValidators: oas.MultipleOfValidators{
+						Number: &oas.ValidatorFunction{
+							Import: &code.Import{
+								Path: "github.com/example/validators",
+							},
+							Function: "validators.NumberMultipleOf",
+						},
+					},
+				},
+			},
+			expected: []schema.NumberValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/example/validators",
+							},
+						},
+						SchemaDefinition: "validators.NumberMultipleOf(5)",
+					},
+				},
+			},
+		},
+		"multipleOf-fractional": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"number"},
+					Minimum:    pointer(float64(0)),
+					Maximum:    pointer(float64(1)),
+					MultipleOf: pointer(float64(0.01)),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					MultipleOfValidators: oas.MultipleOfValidators{
+						Number: &oas.ValidatorFunction{
+							Function: "NumberMultipleOf",
+						},
+					},
+				},
+			},
+			expected: []schema.NumberValidator{
+				{
+					Custom: &schema.CustomValidator{
+						SchemaDefinition: "NumberMultipleOf(0.01)",
+					},
+				},
+			},
+		},
+		"multipleOf-without-function": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:       []string{"number"},
+					Minimum:    pointer(float64(0)),
+					Maximum:    pointer(float64(20)),
+					MultipleOf: pointer(float64(5)),
+				},
+			},
+			expected: nil,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetNumberValidators()
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestBuildNumberResource_warnings(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema               *base.Schema
+		multipleOfValidators oas.MultipleOfValidators
+		expectedLog          string
+	}{
+		"number-bounds": {
+			schema: &base.Schema{
+				Type:    []string{"number"},
+				Minimum: pointer(float64(0)),
+			},
+			expectedLog: "level=WARN msg=\"number bounds can't be validated for number attributes without a double or float format, the bounds won't be validated\" attribute=number_prop\n",
+		},
+		"number-multipleOf": {
+			schema: &base.Schema{
+				Type:       []string{"number"},
+				MultipleOf: pointer(float64(0.01)),
+			},
+			expectedLog: "level=WARN msg=\"multipleOf can only be validated with a function from multiple_of_validators in the generator config, the multiples won't be validated\" attribute=number_prop multiple_of=0.01\n",
+		},
+		"number-multipleOf-validated": {
+			schema: &base.Schema{
+				Type:       []string{"number"},
+				MultipleOf: pointer(float64(0.01)),
+			},
+			multipleOfValidators: oas.MultipleOfValidators{
+				Number: &oas.ValidatorFunction{
+					Function: "NumberMultipleOf",
+				},
+			},
+			expectedLog: "",
+		},
+		"float64-bounds": {
+			schema: &base.Schema{
+				Type:    []string{"number"},
+				Format:  "double",
+				Minimum: pointer(float64(0)),
+			},
+			expectedLog: "",
+		},
+		"float64-multipleOf": {
+			schema: &base.Schema{
+				Type:       []string{"number"},
+				Format:     "double",
+				MultipleOf: pointer(float64(0.5)),
+			},
+			multipleOfValidators: oas.MultipleOfValidators{
+				Number: &oas.ValidatorFunction{
+					Function: "NumberMultipleOf",
+				},
+			},
+			expectedLog: "level=WARN msg=\"multipleOf can only be validated with a function from multiple_of_validators in the generator config, the multiples won't be validated\" attribute=number_prop multiple_of=0.5\n",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var logs bytes.Buffer
+			logger := slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{
+				ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+					if a.Key == slog.TimeKey {
+						return slog.Attr{}
+					}
+					return a
+				},
+			}))
+
+			globalSchemaOpts := oas.GlobalSchemaOpts{
+				MultipleOfValidators: testCase.multipleOfValidators,
+				Logger:               logger,
+			}
+
+			s, err := oas.BuildSchema(base.CreateSchemaProxy(testCase.schema), oas.SchemaOpts{}, globalSchemaOpts)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			_, err = s.BuildNumberResource("number_prop", schema.Optional)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(logs.String(), testCase.expectedLog); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
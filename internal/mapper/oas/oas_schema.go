@@ -5,6 +5,9 @@ package oas
 
 import (
 	"context"
+	"io"
+	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
@@ -12,6 +15,7 @@ import (
 
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 type OASSchema struct {
@@ -31,11 +35,34 @@ type GlobalSchemaOpts struct {
 	// create request for a resource, does not become required from a lower precedence operation, such as an
 	// read response for a resource.
 	OverrideComputability schema.ComputedOptionalRequired
+
+	// FormatCustomTypes maps the `format` of string schemas to framework custom types. Formats that aren't in this map, or are
+	// mapped to nil, are built as plain strings.
+	FormatCustomTypes map[string]*schema.CustomType
+
+	// UniqueItemsAsSets will map arrays with `uniqueItems: true` to sets instead of lists, unless overridden for an attribute
+	// with SchemaOpts.UniqueItemsAsSet.
+	UniqueItemsAsSets bool
+
+	// IgnoreConstAttributes will skip building attributes for schemas with a single allowed value, from `const` or an `enum`
+	// with one value.
+	IgnoreConstAttributes bool
+
+	// MultipleOfValidators are the validator functions that `multipleOf` is mapped to, for each attribute type.
+	MultipleOfValidators MultipleOfValidators
+
+	// Logger is used for warnings about schemas that can be mapped, but likely not as intended by the OpenAPI spec. Warnings
+	// are discarded if nil.
+	Logger *slog.Logger
 }
 
 // SchemaOpts is NOT passed recursively through built OASSchema structs, and will only be available to the top level schema. This is used
 // for options that need to control just the top level schema, like overriding descriptions.
 type SchemaOpts struct {
+	// Aliases contains all potentially relevant aliases for a schema's properties and their potential nested properties, keyed
+	// by property location, with the attribute name to use for the property as the value.
+	Aliases map[string]string
+
 	// Ignores contains all potentially relevant ignores for a schema and it's potential nested schemas
 	Ignores []string
 
@@ -48,6 +75,17 @@ type SchemaOpts struct {
 	// OverrideDescription will set the attribute description to this field if populated, otherwise the attribute description
 	// will be set to the description field of the `schema`.
 	OverrideDescription string
+
+	// UniqueItemsAsSet overrides GlobalSchemaOpts.UniqueItemsAsSets for this schema, if it's an array with `uniqueItems: true`.
+	UniqueItemsAsSet *bool
+
+	// UniqueItemsAsSets contains all potentially relevant UniqueItemsAsSet options for a schema's potential nested schemas, keyed by
+	// attribute location.
+	UniqueItemsAsSets map[string]bool
+
+	// ObjectUnion is populated when the schema is a variant of an object union (oneOf/anyOf), and is used to
+	// generate validators that prevent conflicting variants from being configured.
+	ObjectUnion *ObjectUnion
 }
 
 // IsMap checks the `additionalProperties` field to determine if a map type is appropriate (refer to [JSON Schema - additionalProperties]).
@@ -57,6 +95,15 @@ func (s *OASSchema) IsMap() bool {
 	return s.Schema.AdditionalProperties != nil && s.Schema.AdditionalProperties.IsA()
 }
 
+// logger returns the logger for warnings from the global schema options, or a logger that discards all warnings if none is set.
+func (s *OASSchema) logger() *slog.Logger {
+	if s.GlobalSchemaOpts.Logger == nil {
+		return slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+
+	return s.GlobalSchemaOpts.Logger
+}
+
 // SchemaErrorFromProperty is a helper function for creating an SchemaError struct for a property.
 func (s *OASSchema) SchemaErrorFromProperty(err error, propName string) *SchemaError {
 	return NewSchemaError(err, s.getPropertyLineNumber(propName), propName)
@@ -128,6 +175,22 @@ func (s *OASSchema) IsSensitive() *bool {
 	return &isSensitive
 }
 
+// GetCustomType returns a copy of the framework custom type mapped to the schema format, or nil if there is none.
+func (s *OASSchema) GetCustomType() *schema.CustomType {
+	customType := s.GlobalSchemaOpts.FormatCustomTypes[s.Format]
+	if customType == nil {
+		return nil
+	}
+
+	customTypeCopy := *customType
+	if customType.Import != nil {
+		importCopy := *customType.Import
+		customTypeCopy.Import = &importCopy
+	}
+
+	return &customTypeCopy
+}
+
 // TODO: Figure out a better way to handle computability, since it differs with provider vs. datasource/resource
 func (s *OASSchema) GetComputability(name string) schema.ComputedOptionalRequired {
 	if s.GlobalSchemaOpts.OverrideComputability != "" {
@@ -153,28 +216,202 @@ func (s *OASSchema) GetOptionalOrRequired(name string) schema.OptionalRequired {
 	return schema.Optional
 }
 
-// IsPropertyIgnored checks if a property should be ignored
+// IsPropertyIgnored checks if a property should be ignored, either by name or by an ignore pattern like `**.etag`
 func (s *OASSchema) IsPropertyIgnored(name string) bool {
 	for _, ignore := range s.SchemaOpts.Ignores {
-		if name == ignore {
+		if util.MatchLocation(ignore, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsConstIgnored checks if the schema has a single allowed value and attributes with a single allowed value should be ignored.
+func (s *OASSchema) IsConstIgnored() bool {
+	return s.GlobalSchemaOpts.IgnoreConstAttributes && s.getConst() != nil
+}
+
+// IsNullable checks if the schema allows null values, either with the OAS 3.0 `nullable` keyword or with a `null` type in the
+// OAS 3.1 `type` array. Nullable `oneOf` and `anyOf` schemas are marked as nullable when they are built.
+func (s *OASSchema) IsNullable() bool {
+	if s.Schema.Nullable != nil && *s.Schema.Nullable {
+		return true
+	}
+
+	for _, schemaType := range s.Schema.Type {
+		if schemaType == util.OAS_type_null {
 			return true
 		}
 	}
+
 	return false
 }
 
+// IsReadOnly checks if the schema is marked with `readOnly`, so its value is only ever set by the API.
+func (s *OASSchema) IsReadOnly() bool {
+	return s.Schema.ReadOnly != nil && *s.Schema.ReadOnly
+}
+
+// getConst returns the single allowed value of the schema, from `const` or an `enum` with one value, or nil if more than one
+// value is allowed. A `null` value is never returned, as it's not a value that can be set as a default.
+func (s *OASSchema) getConst() *yaml.Node {
+	if s.Schema.Const != nil {
+		if isNullNode(s.Schema.Const) {
+			return nil
+		}
+
+		return s.Schema.Const
+	}
+
+	if len(s.Schema.Enum) == 1 && !isNullNode(s.Schema.Enum[0]) {
+		return s.Schema.Enum[0]
+	}
+
+	return nil
+}
+
+// getEnum returns the allowed values of the schema, from `const` or `enum`. As `const` is more restrictive, it takes
+// precedence over `enum`. Nullable enums include `null` as an allowed value, which is removed as Terraform doesn't run
+// validators on null values.
+func (s *OASSchema) getEnum() []*yaml.Node {
+	values := s.Schema.Enum
+	if s.Schema.Const != nil {
+		values = []*yaml.Node{s.Schema.Const}
+	}
+
+	enum := make([]*yaml.Node, 0, len(values))
+	for _, value := range values {
+		if isNullNode(value) {
+			continue
+		}
+
+		enum = append(enum, value)
+	}
+
+	return enum
+}
+
+func isNullNode(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && node.ShortTag() == "!!null"
+}
+
+// IsSet checks if an array schema should be mapped to a set instead of a list. Arrays with the custom `set` format are always
+// mapped to sets, and arrays with `uniqueItems: true` are mapped to sets when enabled in the global or schema options.
+func (s *OASSchema) IsSet() bool {
+	if s.Format == util.TF_format_set {
+		return true
+	}
+
+	if s.Schema.UniqueItems == nil || !*s.Schema.UniqueItems {
+		return false
+	}
+
+	if s.SchemaOpts.UniqueItemsAsSet != nil {
+		return *s.SchemaOpts.UniqueItemsAsSet
+	}
+
+	return s.GlobalSchemaOpts.UniqueItemsAsSets
+}
+
+// GetUniqueItemsAsSet returns the UniqueItemsAsSet option for a property, or nil if there is none. An option for the property name
+// takes precedence over options for location patterns that match the property.
+func (s *OASSchema) GetUniqueItemsAsSet(name string) *bool {
+	var result *bool
+
+	for _, location := range util.SortedLocationKeys(s.SchemaOpts.UniqueItemsAsSets) {
+		if util.MatchLocation(location, name) {
+			uniqueItemsAsSet := s.SchemaOpts.UniqueItemsAsSets[location]
+			result = &uniqueItemsAsSet
+		}
+	}
+
+	return result
+}
+
+// GetUniqueItemsAsSetsForNested is a helper function that will return all nested UniqueItemsAsSet options for a property, with
+// the property name removed from the attribute locations. If no nested options are found, returns nil.
+func (s *OASSchema) GetUniqueItemsAsSetsForNested(name string) map[string]bool {
+	var nested map[string]bool
+
+	for _, location := range util.SortedLocationKeys(s.SchemaOpts.UniqueItemsAsSets) {
+		for _, nestedLocation := range util.NestedLocationPatterns(location, name) {
+			if nested == nil {
+				nested = make(map[string]bool)
+			}
+
+			nested[nestedLocation] = s.SchemaOpts.UniqueItemsAsSets[location]
+		}
+	}
+
+	return nested
+}
+
+// GetAlias returns the attribute name for a property, which is the alias of the property if there is one, otherwise the property name.
+func (s *OASSchema) GetAlias(name string) string {
+	if alias, ok := s.SchemaOpts.Aliases[name]; ok {
+		return alias
+	}
+
+	return name
+}
+
+// AttributeLocation returns the attribute location of a property location, with the aliases of the schema options applied, and whether
+// the attribute or any attribute it's nested under is ignored. The property names are resolved one nesting level at a time, the same
+// way as when attributes are built from a schema.
+func AttributeLocation(propertyLocation string, schemaOpts SchemaOpts) (string, bool) {
+	names := strings.Split(propertyLocation, util.LocationSeparator)
+	attributeNames := make([]string, len(names))
+	ignored := false
+
+	s := &OASSchema{SchemaOpts: schemaOpts}
+	for i, name := range names {
+		attributeName := s.GetAlias(name)
+		attributeNames[i] = attributeName
+
+		if s.IsPropertyIgnored(attributeName) {
+			ignored = true
+		}
+
+		s = &OASSchema{
+			SchemaOpts: SchemaOpts{
+				Aliases: s.GetAliasesForNested(name),
+				Ignores: s.GetIgnoresForNested(attributeName),
+			},
+		}
+	}
+
+	return strings.Join(attributeNames, util.LocationSeparator), ignored
+}
+
+// GetAliasesForNested is a helper function that will return all nested aliases for a property, with the property name removed
+// from the property locations. If no nested aliases are found, returns nil.
+func (s *OASSchema) GetAliasesForNested(name string) map[string]string {
+	var nested map[string]string
+
+	for location, alias := range s.SchemaOpts.Aliases {
+		nestedLocation, ok := strings.CutPrefix(location, name+util.LocationSeparator)
+		if !ok || nestedLocation == "" {
+			continue
+		}
+
+		if nested == nil {
+			nested = make(map[string]string)
+		}
+
+		nested[nestedLocation] = alias
+	}
+
+	return nested
+}
+
 // GetIgnoresForNested is a helper function that will return all nested ignores for a property. If no ignores
 // or nested ignores are found, returns an empty string slice.
 func (s *OASSchema) GetIgnoresForNested(name string) []string {
 	newIgnores := make([]string, 0)
 
 	for _, ignore := range s.SchemaOpts.Ignores {
-		ignoreParts := strings.Split(ignore, ".")
-
-		if len(ignoreParts) > 1 && name == ignoreParts[0] {
-			newIgnore := strings.Join(ignoreParts[1:], ".")
-
-			if newIgnore != "" {
+		for _, newIgnore := range util.NestedLocationPatterns(ignore, name) {
+			if !slices.Contains(newIgnores, newIgnore) {
 				newIgnores = append(newIgnores, newIgnore)
 			}
 		}
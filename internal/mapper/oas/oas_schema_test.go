@@ -8,6 +8,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"gopkg.in/yaml.v3"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
 )
@@ -168,6 +169,39 @@ func TestIsPropertyIgnored(t *testing.T) {
 			},
 			want: false,
 		},
+		"property is ignored by pattern": {
+			propertyName: "created_at",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"created_*",
+					},
+				},
+			},
+			want: true,
+		},
+		"property is ignored by recursive pattern": {
+			propertyName: "_links",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"**._links",
+					},
+				},
+			},
+			want: true,
+		},
+		"property is not ignored by nested pattern": {
+			propertyName: "etag",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"*.etag",
+					},
+				},
+			},
+			want: false,
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -232,6 +266,27 @@ func TestGetIgnoresForNested(t *testing.T) {
 				"ignore_me_3",
 			},
 		},
+		"nested ignore patterns exist": {
+			propertyName: "prop",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Ignores: []string{
+						"*.etag",
+						"**._links",
+						"**.prop.id",
+						"pr*.tags.*",
+						"not_*.name",
+					},
+				},
+			},
+			want: []string{
+				"etag",
+				"**._links",
+				"**.prop.id",
+				"id",
+				"tags.*",
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -246,3 +301,413 @@ func TestGetIgnoresForNested(t *testing.T) {
 		})
 	}
 }
+
+func TestIsSet(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema oas.OASSchema
+		want   bool
+	}{
+		"list": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"array"},
+				},
+			},
+			want: false,
+		},
+		"set format": {
+			schema: oas.OASSchema{
+				Format: "set",
+				Schema: &base.Schema{
+					Type:   []string{"array"},
+					Format: "set",
+				},
+			},
+			want: true,
+		},
+		"unique items": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:        []string{"array"},
+					UniqueItems: pointer(true),
+				},
+			},
+			want: false,
+		},
+		"unique items as sets": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:        []string{"array"},
+					UniqueItems: pointer(true),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					UniqueItemsAsSets: true,
+				},
+			},
+			want: true,
+		},
+		"unique items as sets, not unique": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:        []string{"array"},
+					UniqueItems: pointer(false),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					UniqueItemsAsSets: true,
+				},
+			},
+			want: false,
+		},
+		"unique items as sets, overridden to list": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:        []string{"array"},
+					UniqueItems: pointer(true),
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					UniqueItemsAsSets: true,
+				},
+				SchemaOpts: oas.SchemaOpts{
+					UniqueItemsAsSet: pointer(false),
+				},
+			},
+			want: false,
+		},
+		"unique items, overridden to set": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:        []string{"array"},
+					UniqueItems: pointer(true),
+				},
+				SchemaOpts: oas.SchemaOpts{
+					UniqueItemsAsSet: pointer(true),
+				},
+			},
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.IsSet()
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsNullable(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema oas.OASSchema
+		want   bool
+	}{
+		"not nullable": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"string"},
+				},
+			},
+			want: false,
+		},
+		"nullable false": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:     []string{"string"},
+					Nullable: pointer(false),
+				},
+			},
+			want: false,
+		},
+		"nullable true": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:     []string{"string"},
+					Nullable: pointer(true),
+				},
+			},
+			want: true,
+		},
+		"null type": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"null", "string"},
+				},
+			},
+			want: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.IsNullable()
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestIsConstIgnored(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema oas.OASSchema
+		want   bool
+	}{
+		"const, not ignored": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:  []string{"string"},
+					Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "v1"},
+				},
+			},
+			want: false,
+		},
+		"const, ignored": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:  []string{"string"},
+					Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "v1"},
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					IgnoreConstAttributes: true,
+				},
+			},
+			want: true,
+		},
+		"single value enum, ignored": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"string"},
+					Enum: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Value: "v1"},
+					},
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					IgnoreConstAttributes: true,
+				},
+			},
+			want: true,
+		},
+		"multiple value enum, not ignored": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"string"},
+					Enum: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Value: "v1"},
+						{Kind: yaml.ScalarNode, Value: "v2"},
+					},
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					IgnoreConstAttributes: true,
+				},
+			},
+			want: false,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.IsConstIgnored()
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetUniqueItemsAsSetsForNested(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema       oas.OASSchema
+		propertyName string
+		want         map[string]bool
+	}{
+		"options are empty": {
+			propertyName: "prop",
+			schema:       oas.OASSchema{},
+			want:         nil,
+		},
+		"nested options exist": {
+			propertyName: "prop",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					UniqueItemsAsSets: map[string]bool{
+						"prop":              true,
+						"prop.":             true,
+						"prop.set_me":       true,
+						"prop.nested.list":  false,
+						"not_me.prop":       true,
+						"property.not_me_2": true,
+					},
+				},
+			},
+			want: map[string]bool{
+				"set_me":      true,
+				"nested.list": false,
+			},
+		},
+		"nested pattern options exist": {
+			propertyName: "prop",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					UniqueItemsAsSets: map[string]bool{
+						"*.tags":      true,
+						"prop.tags":   false,
+						"**.labels":   true,
+						"not_*.names": true,
+					},
+				},
+			},
+			want: map[string]bool{
+				"tags":      false,
+				"**.labels": true,
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetUniqueItemsAsSetsForNested(testCase.propertyName)
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestGetAliasesForNested(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		schema       oas.OASSchema
+		propertyName string
+		want         map[string]string
+	}{
+		"aliases are empty": {
+			propertyName: "prop",
+			schema:       oas.OASSchema{},
+			want:         nil,
+		},
+		"nested aliases exist": {
+			propertyName: "prop",
+			schema: oas.OASSchema{
+				SchemaOpts: oas.SchemaOpts{
+					Aliases: map[string]string{
+						"prop":               "top_level",
+						"prop.":              "invalid",
+						"prop.replicas":      "replica_count",
+						"prop.nested.itemId": "item_id",
+						"not_me.prop":        "not_me",
+						"property.not_me_2":  "not_me_2",
+					},
+				},
+			},
+			want: map[string]string{
+				"replicas":      "replica_count",
+				"nested.itemId": "item_id",
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.schema.GetAliasesForNested(testCase.propertyName)
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestAttributeLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		propertyLocation string
+		schemaOpts       oas.SchemaOpts
+		wantLocation     string
+		wantIgnored      bool
+	}{
+		"no schema options": {
+			propertyLocation: "spec.replicas",
+			wantLocation:     "spec.replicas",
+		},
+		"nested aliases": {
+			propertyLocation: "spec.replicaCount",
+			schemaOpts: oas.SchemaOpts{
+				Aliases: map[string]string{
+					"spec":              "specification",
+					"spec.replicaCount": "replica_count",
+					"replicaCount":      "not_me",
+				},
+			},
+			wantLocation: "specification.replica_count",
+		},
+		"ignored by aliased location": {
+			propertyLocation: "spec.replicaCount",
+			schemaOpts: oas.SchemaOpts{
+				Aliases: map[string]string{
+					"spec.replicaCount": "replica_count",
+				},
+				Ignores: []string{"spec.replica_count"},
+			},
+			wantLocation: "spec.replica_count",
+			wantIgnored:  true,
+		},
+		"ignored by parent pattern": {
+			propertyLocation: "metadata.labels.name",
+			schemaOpts: oas.SchemaOpts{
+				Ignores: []string{"**.labels"},
+			},
+			wantLocation: "metadata.labels.name",
+			wantIgnored:  true,
+		},
+		"not ignored by original property name": {
+			propertyLocation: "spec.replicaCount",
+			schemaOpts: oas.SchemaOpts{
+				Aliases: map[string]string{
+					"spec.replicaCount": "replica_count",
+				},
+				Ignores: []string{"spec.replicaCount"},
+			},
+			wantLocation: "spec.replica_count",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			gotLocation, gotIgnored := oas.AttributeLocation(testCase.propertyLocation, testCase.schemaOpts)
+			if gotLocation != testCase.wantLocation {
+				t.Errorf("expected location %q, got: %q", testCase.wantLocation, gotLocation)
+			}
+			if gotIgnored != testCase.wantIgnored {
+				t.Errorf("expected ignored to be %t, got: %t", testCase.wantIgnored, gotIgnored)
+			}
+		})
+	}
+}
@@ -17,14 +17,16 @@ func (s *OASSchema) BuildObjectElementType() (schema.ElementType, *SchemaError)
 	sortedProperties := orderedmap.SortAlpha(s.Schema.Properties)
 	for pair := range orderedmap.Iterate(context.TODO(), sortedProperties) {
 		name := pair.Key()
+		attributeName := s.GetAlias(name)
 
-		if s.IsPropertyIgnored(name) {
+		if s.IsPropertyIgnored(attributeName) {
 			continue
 		}
 
 		pProxy := pair.Value()
 		schemaOpts := SchemaOpts{
-			Ignores: s.GetIgnoresForNested(name),
+			Aliases: s.GetAliasesForNested(name),
+			Ignores: s.GetIgnoresForNested(attributeName),
 		}
 
 		pSchema, err := BuildSchema(pProxy, schemaOpts, s.GlobalSchemaOpts)
@@ -32,12 +34,16 @@ func (s *OASSchema) BuildObjectElementType() (schema.ElementType, *SchemaError)
 			return schema.ElementType{}, s.NestSchemaError(err, name)
 		}
 
+		if pSchema.IsConstIgnored() {
+			continue
+		}
+
 		elemType, err := pSchema.BuildElementType()
 		if err != nil {
 			return schema.ElementType{}, s.NestSchemaError(err, name)
 		}
 
-		objectElemTypes = append(objectElemTypes, util.CreateObjectAttributeType(name, elemType))
+		objectElemTypes = append(objectElemTypes, util.CreateObjectAttributeType(attributeName, elemType))
 	}
 
 	return schema.ElementType{
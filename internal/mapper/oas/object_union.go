@@ -0,0 +1,191 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package oas
+
+import (
+	"context"
+	"fmt"
+	"path"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+// ObjectUnion contains information about an object union, which is mapped from oneOf/anyOf subschemas that are all objects. Each
+// subschema is mapped to an optional nested attribute (variant), with validators to prevent conflicting variants from being configured.
+type ObjectUnion struct {
+	// Keyword is the schema composition keyword that the union was built from, either oneOf or anyOf.
+	Keyword string
+
+	// Variants contains the names of all variant attributes in the union.
+	Variants []string
+}
+
+// buildObjectUnion will return an object schema with a property for each subschema if all of the non-null subschemas are objects. If
+// the subschemas are not an object union, a nil schema will be returned.
+//
+// Each property (variant) name is determined by, in order:
+//   - The key of the discriminator mapping that matches the subschema $ref
+//   - The name of the subschema $ref, i.e. #/components/schemas/S3Source = S3Source
+//   - The subschema title
+func buildObjectUnion(s *base.Schema, keyword NodeType) (*base.Schema, *SchemaError) {
+	proxies, keywordName := s.OneOf, util.OAS_keyword_one_of
+	if keyword == AnyOf {
+		proxies, keywordName = s.AnyOf, util.OAS_keyword_any_of
+	}
+
+	nullable := s.Nullable != nil && *s.Nullable
+	variantProxies := []*base.SchemaProxy{}
+	variantSchemas := []*base.Schema{}
+	for _, proxy := range proxies {
+		variantSchema, err := buildSchemaProxy(proxy)
+		if err != nil {
+			return nil, err
+		}
+
+		variantType, err := retrieveType(variantSchema)
+		if err != nil {
+			return nil, err
+		}
+
+		if variantType == util.OAS_type_null {
+			nullable = true
+			continue
+		}
+
+		isMap := variantSchema.AdditionalProperties != nil && variantSchema.AdditionalProperties.IsA()
+		if variantType != util.OAS_type_object || isMap {
+			return nil, nil
+		}
+
+		variantProxies = append(variantProxies, proxy)
+		variantSchemas = append(variantSchemas, variantSchema)
+	}
+
+	// A single object is handled as a nullable type, not a union
+	if len(variantProxies) < 2 {
+		return nil, nil
+	}
+
+	properties := orderedmap.New[string, *base.SchemaProxy]()
+	for i, proxy := range variantProxies {
+		name := getObjectUnionVariantName(s.Discriminator, proxy, variantSchemas[i])
+		if name == "" {
+			return nil, SchemaErrorFromNode(fmt.Errorf("unable to determine a name for %s subschema at index %d, a $ref, discriminator mapping, or title is required", keywordName, i), s, keyword)
+		}
+
+		if _, ok := properties.Get(name); ok {
+			return nil, SchemaErrorFromNode(fmt.Errorf("found duplicate %s subschema name '%s'", keywordName, name), s, keyword)
+		}
+
+		properties.Set(name, proxy)
+	}
+
+	extensions := orderedmap.New[string, *yaml.Node]()
+	extensions.Set(util.TF_extension_object_union, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: keywordName})
+
+	unionSchema := &base.Schema{
+		Type:        []string{util.OAS_type_object},
+		Description: s.Description,
+		Deprecated:  s.Deprecated,
+		Properties:  properties,
+		Extensions:  extensions,
+		ParentProxy: s.ParentProxy,
+	}
+
+	if nullable {
+		unionSchema.Nullable = &nullable
+	}
+
+	return unionSchema, nil
+}
+
+func getObjectUnionVariantName(discriminator *base.Discriminator, proxy *base.SchemaProxy, variantSchema *base.Schema) string {
+	ref := ""
+	if proxy.IsReference() {
+		ref = proxy.GetReference()
+	}
+
+	if ref != "" && discriminator != nil && discriminator.Mapping != nil {
+		for pair := range orderedmap.Iterate(context.TODO(), discriminator.Mapping) {
+			if pair.Value() == ref || path.Base(pair.Value()) == path.Base(ref) {
+				return pair.Key()
+			}
+		}
+	}
+
+	if ref != "" {
+		return path.Base(ref)
+	}
+
+	return variantSchema.Title
+}
+
+// GetObjectUnion returns the object union information if the schema was built from oneOf/anyOf object subschemas, otherwise returns nil.
+func (s *OASSchema) GetObjectUnion() *ObjectUnion {
+	if s.Schema.Extensions == nil {
+		return nil
+	}
+
+	keywordNode, ok := s.Schema.Extensions.Get(util.TF_extension_object_union)
+	if !ok || keywordNode == nil {
+		return nil
+	}
+
+	union := &ObjectUnion{
+		Keyword: keywordNode.Value,
+	}
+
+	for pair := range orderedmap.Iterate(context.TODO(), s.Schema.Properties) {
+		if s.IsPropertyIgnored(pair.Key()) {
+			continue
+		}
+
+		union.Variants = append(union.Variants, pair.Key())
+	}
+
+	return union
+}
+
+// GetObjectValidators returns validators for a variant of an object union, ensuring that conflicting variants are not configured:
+//   - oneOf: objectvalidator.ExactlyOneOf, as exactly one subschema must match
+//   - anyOf: objectvalidator.ConflictsWith, as subschemas may overlap and an empty object could match multiple subschemas
+func (s *OASSchema) GetObjectValidators(name string) []schema.ObjectValidator {
+	union := s.SchemaOpts.ObjectUnion
+	if union == nil {
+		return nil
+	}
+
+	siblings := []string{}
+	for _, variant := range union.Variants {
+		if variant == name {
+			continue
+		}
+
+		siblings = append(siblings, util.TerraformIdentifier(variant))
+	}
+
+	var customValidator *schema.CustomValidator
+	switch union.Keyword {
+	case util.OAS_keyword_one_of:
+		customValidator = frameworkvalidators.ObjectValidatorExactlyOneOf(siblings)
+	case util.OAS_keyword_any_of:
+		customValidator = frameworkvalidators.ObjectValidatorConflictsWith(siblings)
+	}
+
+	if customValidator == nil {
+		return nil
+	}
+
+	return []schema.ObjectValidator{
+		{
+			Custom: customValidator,
+		},
+	}
+}
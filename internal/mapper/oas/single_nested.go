@@ -5,6 +5,7 @@ package oas
 
 import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkdefaults"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
@@ -17,7 +18,7 @@ func (s *OASSchema) BuildSingleNestedResource(name string, computability schema.
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	return &attrmapper.ResourceSingleNestedAttribute{
+	result := &attrmapper.ResourceSingleNestedAttribute{
 		Name:       name,
 		Attributes: objectAttributes,
 		SingleNestedAttribute: resource.SingleNestedAttribute{
@@ -25,7 +26,17 @@ func (s *OASSchema) BuildSingleNestedResource(name string, computability schema.
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
 		},
-	}, nil
+	}
+
+	result.DefaultValue = s.getDefaultValue(name, s, func(objectType schema.ElementType, value any) (*schema.CustomDefault, error) {
+		return frameworkdefaults.ObjectDefaultStaticValue(objectType.Object.AttributeTypes, value)
+	})
+
+	if computability != schema.Computed {
+		result.Validators = s.GetObjectValidators(name)
+	}
+
+	return result, nil
 }
 
 func (s *OASSchema) BuildSingleNestedDataSource(name string, computability schema.ComputedOptionalRequired) (attrmapper.DataSourceAttribute, *SchemaError) {
@@ -34,7 +45,7 @@ func (s *OASSchema) BuildSingleNestedDataSource(name string, computability schem
 		return nil, s.NestSchemaError(err, name)
 	}
 
-	return &attrmapper.DataSourceSingleNestedAttribute{
+	result := &attrmapper.DataSourceSingleNestedAttribute{
 		Name:       name,
 		Attributes: objectAttributes,
 		SingleNestedAttribute: datasource.SingleNestedAttribute{
@@ -42,7 +53,13 @@ func (s *OASSchema) BuildSingleNestedDataSource(name string, computability schem
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
 		},
-	}, nil
+	}
+
+	if computability != schema.Computed {
+		result.Validators = s.GetObjectValidators(name)
+	}
+
+	return result, nil
 }
 
 func (s *OASSchema) BuildSingleNestedProvider(name string, optionalOrRequired schema.OptionalRequired) (attrmapper.ProviderAttribute, *SchemaError) {
@@ -58,6 +75,7 @@ func (s *OASSchema) BuildSingleNestedProvider(name string, optionalOrRequired sc
 			OptionalRequired:   optionalOrRequired,
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
+			Validators:         s.GetObjectValidators(name),
 		},
 	}, nil
 }
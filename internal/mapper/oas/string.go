@@ -17,15 +17,16 @@ func (s *OASSchema) BuildStringResource(name string, computability schema.Comput
 		Name: name,
 		StringAttribute: resource.StringAttribute{
 			ComputedOptionalRequired: computability,
+			CustomType:               s.GetCustomType(),
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
 			Sensitive:                s.IsSensitive(),
 		},
 	}
 
-	if s.Schema.Default != nil {
+	if defaultNode := s.getDefault(); defaultNode != nil {
 		var staticDefault string
-		if err := s.Schema.Default.Decode(&staticDefault); err == nil {
+		if err := defaultNode.Decode(&staticDefault); err == nil {
 			if computability == schema.Required {
 				result.ComputedOptionalRequired = schema.ComputedOptional
 			}
@@ -33,6 +34,8 @@ func (s *OASSchema) BuildStringResource(name string, computability schema.Comput
 			result.Default = &schema.StringDefault{
 				Static: &staticDefault,
 			}
+		} else {
+			s.warnOnInvalidDefault(name, err)
 		}
 	}
 
@@ -48,6 +51,7 @@ func (s *OASSchema) BuildStringDataSource(name string, computability schema.Comp
 		Name: name,
 		StringAttribute: datasource.StringAttribute{
 			ComputedOptionalRequired: computability,
+			CustomType:               s.GetCustomType(),
 			DeprecationMessage:       s.GetDeprecationMessage(),
 			Description:              s.GetDescription(),
 			Sensitive:                s.IsSensitive(),
@@ -66,6 +70,7 @@ func (s *OASSchema) BuildStringProvider(name string, optionalOrRequired schema.O
 		Name: name,
 		StringAttribute: provider.StringAttribute{
 			OptionalRequired:   optionalOrRequired,
+			CustomType:         s.GetCustomType(),
 			DeprecationMessage: s.GetDeprecationMessage(),
 			Description:        s.GetDescription(),
 			Sensitive:          s.IsSensitive(),
@@ -78,17 +83,19 @@ func (s *OASSchema) BuildStringProvider(name string, optionalOrRequired schema.O
 
 func (s *OASSchema) BuildStringElementType() (schema.ElementType, *SchemaError) {
 	return schema.ElementType{
-		String: &schema.StringType{},
+		String: &schema.StringType{
+			CustomType: s.GetCustomType(),
+		},
 	}, nil
 }
 
 func (s *OASSchema) GetStringValidators() []schema.StringValidator {
 	var result []schema.StringValidator
 
-	if len(s.Schema.Enum) > 0 {
+	if len(s.getEnum()) > 0 {
 		var enum []string
 
-		for _, valueNode := range s.Schema.Enum {
+		for _, valueNode := range s.getEnum() {
 			var value string
 			if err := valueNode.Decode(&value); err != nil {
 				// could consider error/panic here to notify developers
@@ -132,5 +139,12 @@ func (s *OASSchema) GetStringValidators() []schema.StringValidator {
 		})
 	}
 
+	// Custom types are expected to validate their own format, so the format validator is only needed for plain strings
+	if formatValidator := frameworkvalidators.StringValidatorFormat(s.Format); formatValidator != nil && s.GetCustomType() == nil {
+		result = append(result, schema.StringValidator{
+			Custom: formatValidator,
+		})
+	}
+
 	return result
 }
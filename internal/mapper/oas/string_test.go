@@ -112,6 +112,96 @@ func TestBuildStringResource(t *testing.T) {
 				},
 			},
 		},
+		"string attributes const": {
+			schema: &base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"string_prop_const", "string_prop_single_enum"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"string_prop_const": base.CreateSchemaProxy(&base.Schema{
+						Type:  []string{"string"},
+						Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "v1"},
+					}),
+					"string_prop_const_default": base.CreateSchemaProxy(&base.Schema{
+						Type:    []string{"string"},
+						Const:   &yaml.Node{Kind: yaml.ScalarNode, Value: "v1"},
+						Default: &yaml.Node{Kind: yaml.ScalarNode, Value: "v2"},
+					}),
+					"string_prop_single_enum": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+						Enum: []*yaml.Node{
+							{Kind: yaml.ScalarNode, Value: "pet"},
+						},
+					}),
+				}),
+			},
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_prop_const",
+					StringAttribute: resource.StringAttribute{
+						// Intentionally not required due to default
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.StringDefault{
+							Static: pointer("v1"),
+						},
+						Validators: []schema.StringValidator{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+										},
+									},
+									SchemaDefinition: "stringvalidator.OneOf(\n\"v1\",\n)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_prop_const_default",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.StringDefault{
+							Static: pointer("v2"),
+						},
+						Validators: []schema.StringValidator{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+										},
+									},
+									SchemaDefinition: "stringvalidator.OneOf(\n\"v1\",\n)",
+								},
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "string_prop_single_enum",
+					StringAttribute: resource.StringAttribute{
+						// Intentionally not required due to default
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Default: &schema.StringDefault{
+							Static: pointer("pet"),
+						},
+						Validators: []schema.StringValidator{
+							{
+								Custom: &schema.CustomValidator{
+									Imports: []code.Import{
+										{
+											Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+										},
+									},
+									SchemaDefinition: "stringvalidator.OneOf(\n\"pet\",\n)",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		"string attributes deprecated": {
 			schema: &base.Schema{
 				Type: []string{"object"},
@@ -563,6 +653,185 @@ func TestBuildStringProvider(t *testing.T) {
 	}
 }
 
+func TestBuildStringCustomType(t *testing.T) {
+	t.Parallel()
+
+	rfc3339CustomType := &schema.CustomType{
+		Import: &code.Import{
+			Path: "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes",
+		},
+		Type:      "timetypes.RFC3339Type{}",
+		ValueType: "timetypes.RFC3339",
+	}
+
+	// Formats without a custom type are validated as plain strings instead
+	uuidValidator := &schema.CustomValidator{
+		Imports: []code.Import{
+			{
+				Path: "regexp",
+			},
+			{
+				Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+			},
+		},
+		SchemaDefinition: "stringvalidator.RegexMatches(regexp.MustCompile(\"^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$\"), \"must be a valid UUID\")",
+	}
+
+	testSchema := &base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"date_time_prop"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"date_time_prop": base.CreateSchemaProxy(&base.Schema{
+				Type:   []string{"string"},
+				Format: "date-time",
+			}),
+			"date_time_list_prop": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type:   []string{"string"},
+						Format: "date-time",
+					}),
+				},
+			}),
+			"uuid_prop": base.CreateSchemaProxy(&base.Schema{
+				Type:   []string{"string"},
+				Format: "uuid",
+			}),
+		}),
+	}
+	globalSchemaOpts := oas.GlobalSchemaOpts{
+		FormatCustomTypes: map[string]*schema.CustomType{
+			"date-time": rfc3339CustomType,
+		},
+	}
+
+	testCases := map[string]struct {
+		build              func(oas.OASSchema) (any, *oas.SchemaError)
+		expectedAttributes any
+	}{
+		"resource": {
+			build: func(s oas.OASSchema) (any, *oas.SchemaError) { return s.BuildResourceAttributes() },
+			expectedAttributes: attrmapper.ResourceAttributes{
+				&attrmapper.ResourceListAttribute{
+					Name: "date_time_list_prop",
+					ListAttribute: resource.ListAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						ElementType: schema.ElementType{
+							String: &schema.StringType{
+								CustomType: rfc3339CustomType,
+							},
+						},
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "date_time_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						CustomType:               rfc3339CustomType,
+					},
+				},
+				&attrmapper.ResourceStringAttribute{
+					Name: "uuid_prop",
+					StringAttribute: resource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: uuidValidator,
+							},
+						},
+					},
+				},
+			},
+		},
+		"data source": {
+			build: func(s oas.OASSchema) (any, *oas.SchemaError) { return s.BuildDataSourceAttributes() },
+			expectedAttributes: attrmapper.DataSourceAttributes{
+				&attrmapper.DataSourceListAttribute{
+					Name: "date_time_list_prop",
+					ListAttribute: datasource.ListAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						ElementType: schema.ElementType{
+							String: &schema.StringType{
+								CustomType: rfc3339CustomType,
+							},
+						},
+					},
+				},
+				&attrmapper.DataSourceStringAttribute{
+					Name: "date_time_prop",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						CustomType:               rfc3339CustomType,
+					},
+				},
+				&attrmapper.DataSourceStringAttribute{
+					Name: "uuid_prop",
+					StringAttribute: datasource.StringAttribute{
+						ComputedOptionalRequired: schema.ComputedOptional,
+						Validators: schema.StringValidators{
+							{
+								Custom: uuidValidator,
+							},
+						},
+					},
+				},
+			},
+		},
+		"provider": {
+			build: func(s oas.OASSchema) (any, *oas.SchemaError) { return s.BuildProviderAttributes() },
+			expectedAttributes: attrmapper.ProviderAttributes{
+				&attrmapper.ProviderListAttribute{
+					Name: "date_time_list_prop",
+					ListAttribute: provider.ListAttribute{
+						OptionalRequired: schema.Optional,
+						ElementType: schema.ElementType{
+							String: &schema.StringType{
+								CustomType: rfc3339CustomType,
+							},
+						},
+					},
+				},
+				&attrmapper.ProviderStringAttribute{
+					Name: "date_time_prop",
+					StringAttribute: provider.StringAttribute{
+						OptionalRequired: schema.Required,
+						CustomType:       rfc3339CustomType,
+					},
+				},
+				&attrmapper.ProviderStringAttribute{
+					Name: "uuid_prop",
+					StringAttribute: provider.StringAttribute{
+						OptionalRequired: schema.Optional,
+						Validators: schema.StringValidators{
+							{
+								Custom: uuidValidator,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			s := oas.OASSchema{Schema: testSchema, GlobalSchemaOpts: globalSchemaOpts}
+			attributes, err := testCase.build(s)
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if diff := cmp.Diff(attributes, testCase.expectedAttributes); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
 func TestGetStringValidators(t *testing.T) {
 	t.Parallel()
 
@@ -601,6 +870,42 @@ func TestGetStringValidators(t *testing.T) {
 				},
 			},
 		},
+		"nullable enum": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type:     []string{"string"},
+					Nullable: pointer(true),
+					Enum: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Value: "one"},
+						{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"},
+						{Kind: yaml.ScalarNode, Value: "two"},
+					},
+				},
+			},
+			expected: []schema.StringValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+						},
+						SchemaDefinition: "stringvalidator.OneOf(\n\"one\",\n\"two\",\n)",
+					},
+				},
+			},
+		},
+		"nullable enum, only null": {
+			schema: oas.OASSchema{
+				Schema: &base.Schema{
+					Type: []string{"string", "null"},
+					Enum: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Tag: "!!null", Value: "null"},
+					},
+				},
+			},
+			expected: nil,
+		},
 		"maxLength": {
 			schema: oas.OASSchema{
 				Schema: &base.Schema{
@@ -685,6 +990,86 @@ func TestGetStringValidators(t *testing.T) {
 				},
 			},
 		},
+		"format": {
+			schema: oas.OASSchema{
+				Format: "uuid",
+				Schema: &base.Schema{
+					Type:   []string{"string"},
+					Format: "uuid",
+				},
+			},
+			expected: []schema.StringValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "regexp",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+						},
+						SchemaDefinition: "stringvalidator.RegexMatches(regexp.MustCompile(\"^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$\"), \"must be a valid UUID\")",
+					},
+				},
+			},
+		},
+		"format with custom type": {
+			schema: oas.OASSchema{
+				Format: "uuid",
+				Schema: &base.Schema{
+					Type:   []string{"string"},
+					Format: "uuid",
+				},
+				GlobalSchemaOpts: oas.GlobalSchemaOpts{
+					FormatCustomTypes: map[string]*schema.CustomType{
+						"uuid": {
+							Type:      "uuidtypes.UUIDType{}",
+							ValueType: "uuidtypes.UUID",
+						},
+					},
+				},
+			},
+			expected: nil,
+		},
+		"pattern-and-format": {
+			schema: oas.OASSchema{
+				Format: "email",
+				Schema: &base.Schema{
+					Type:    []string{"string"},
+					Format:  "email",
+					Pattern: "@example\\.com$",
+				},
+			},
+			expected: []schema.StringValidator{
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "regexp",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+						},
+						SchemaDefinition: "stringvalidator.RegexMatches(regexp.MustCompile(\"@example\\\\.com$\"), \"\")",
+					},
+				},
+				{
+					Custom: &schema.CustomValidator{
+						Imports: []code.Import{
+							{
+								Path: "regexp",
+							},
+							{
+								Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+							},
+						},
+						SchemaDefinition: "stringvalidator.RegexMatches(regexp.MustCompile(\"^[^@\\\\s]+@[^@\\\\s]+\\\\.[^@\\\\s]+$\"), \"must be a valid email address\")",
+					},
+				},
+			},
+		},
 	}
 
 	for name, testCase := range testCases {
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/log"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/attrmapper"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/provider"
 )
@@ -22,8 +23,7 @@ type ProviderMapper interface {
 
 type providerMapper struct {
 	provider explorer.Provider
-	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg      config.Config
 }
 
 func NewProviderMapper(exploredProvider explorer.Provider, cfg config.Config) ProviderMapper {
@@ -44,7 +44,7 @@ func (m providerMapper) MapToIR(logger *slog.Logger) (*provider.Provider, error)
 
 	pLogger := logger.With("provider", providerIR.Name)
 
-	providerSchema, err := generateProviderSchema(pLogger, m.provider)
+	providerSchema, err := generateProviderSchema(pLogger, m.provider, newGlobalSchemaOpts(m.cfg))
 	if err != nil {
 		return nil, err
 	}
@@ -53,13 +53,27 @@ func (m providerMapper) MapToIR(logger *slog.Logger) (*provider.Provider, error)
 	return &providerIR, nil
 }
 
-func generateProviderSchema(logger *slog.Logger, exploredProvider explorer.Provider) (*provider.Schema, error) {
+func generateProviderSchema(logger *slog.Logger, exploredProvider explorer.Provider, globalSchemaOpts oas.GlobalSchemaOpts) (*provider.Schema, error) {
 	providerSchema := &provider.Schema{}
 
+	attributes, err := generateProviderAttributes(logger, exploredProvider, globalSchemaOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	providerSchema.Attributes = attributes.ToSpec()
+
+	return providerSchema, nil
+}
+
+// generateProviderAttributes maps all attributes of the provider schema, with ignores applied.
+func generateProviderAttributes(logger *slog.Logger, exploredProvider explorer.Provider, globalSchemaOpts oas.GlobalSchemaOpts) (attrmapper.ProviderAttributes, error) {
+	globalSchemaOpts.Logger = logger
+
 	schemaOpts := oas.SchemaOpts{
 		Ignores: exploredProvider.Ignores,
 	}
-	s, err := oas.BuildSchema(exploredProvider.SchemaProxy, schemaOpts, oas.GlobalSchemaOpts{})
+	s, err := oas.BuildSchema(exploredProvider.SchemaProxy, schemaOpts, globalSchemaOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +85,5 @@ func generateProviderSchema(logger *slog.Logger, exploredProvider explorer.Provi
 		return nil, fmt.Errorf("error mapping provider schema: %w", err)
 	}
 
-	providerSchema.Attributes = attributes.ToSpec()
-
-	return providerSchema, nil
+	return attributes, nil
 }
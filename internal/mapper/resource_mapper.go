@@ -5,6 +5,7 @@ package mapper
 
 import (
 	"errors"
+	"fmt"
 	"log/slog"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
@@ -25,8 +26,7 @@ type ResourceMapper interface {
 
 type resourceMapper struct {
 	resources map[string]explorer.Resource
-	//nolint:unused // Might be useful later!
-	cfg config.Config
+	cfg       config.Config
 }
 
 func NewResourceMapper(resources map[string]explorer.Resource, cfg config.Config) ResourceMapper {
@@ -38,14 +38,23 @@ func NewResourceMapper(resources map[string]explorer.Resource, cfg config.Config
 
 func (m resourceMapper) MapToIR(logger *slog.Logger) ([]resource.Resource, error) {
 	resourceSchemas := []resource.Resource{}
+	var errResult error
+
+	globalSchemaOpts := newGlobalSchemaOpts(m.cfg)
+	schemaDefaults := explorer.SchemaDefaults(m.cfg)
 
 	// Guarantee the order of processing
 	resourceNames := util.SortedKeys(m.resources)
 	for _, name := range resourceNames {
 		explorerResource := m.resources[name]
+		explorerResource.SchemaOptions = explorerResource.SchemaOptions.WithDefaults(schemaDefaults)
 		rLogger := logger.With("resource", name)
 
-		schema, err := generateResourceSchema(rLogger, explorerResource)
+		schema, err := generateResourceSchema(rLogger, explorerResource, globalSchemaOpts)
+		if errors.Is(err, ErrInvalidOverride) {
+			errResult = errors.Join(errResult, fmt.Errorf("resource '%s': %w", name, err))
+			continue
+		}
 		if err != nil {
 			log.WarnLogOnError(rLogger, err, "skipping resource schema mapping")
 			continue
@@ -57,23 +66,48 @@ func (m resourceMapper) MapToIR(logger *slog.Logger) ([]resource.Resource, error
 		})
 	}
 
-	return resourceSchemas, nil
+	return resourceSchemas, errResult
 }
 
-func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resource) (*resource.Schema, error) {
+func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resource, globalSchemaOpts oas.GlobalSchemaOpts) (*resource.Schema, error) {
 	resourceSchema := &resource.Schema{
 		Attributes: []resource.Attribute{},
 	}
 
+	resourceAttributes, err := generateResourceAttributes(logger, explorerResource, globalSchemaOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceAttributes, err = resourceAttributes.ApplyOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidOverride, err)
+	}
+
+	err = resourceAttributes.ResolveDefaults()
+	log.WarnLogOnError(logger, err, "skipping defaults that don't match the merged attributes")
+
+	resourceSchema.Attributes = resourceAttributes.ToSpec()
+	return resourceSchema, nil
+}
+
+// generateResourceAttributes maps and merges all attributes of the resource, with ignores applied. Overrides are not applied.
+func generateResourceAttributes(logger *slog.Logger, explorerResource explorer.Resource, globalSchemaOpts oas.GlobalSchemaOpts) (attrmapper.ResourceAttributes, error) {
+	globalSchemaOpts.Logger = logger
+	setOverrides := uniqueItemsAsSets(explorerResource.SchemaOptions.AttributeOptions.Overrides)
+	bodyAliases := propertyAliases(explorerResource.ReadOpParameters(), explorerResource.SchemaOptions.AttributeOptions.Aliases)
+
 	// ********************
 	// Create Request Body (required)
 	// ********************
 	logger.Debug("searching for create operation request body")
 
 	schemaOpts := oas.SchemaOpts{
-		Ignores: explorerResource.SchemaOptions.Ignores,
+		Aliases:           bodyAliases,
+		Ignores:           explorerResource.SchemaOptions.Ignores,
+		UniqueItemsAsSets: setOverrides,
 	}
-	createRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.CreateOp, schemaOpts, oas.GlobalSchemaOpts{})
+	createRequestSchema, err := oas.BuildSchemaFromRequest(explorerResource.CreateOp, schemaOpts, globalSchemaOpts)
 	if err != nil {
 		return nil, err
 	}
@@ -89,12 +123,13 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 
 	createResponseAttributes := attrmapper.ResourceAttributes{}
 	schemaOpts = oas.SchemaOpts{
-		Ignores: explorerResource.SchemaOptions.Ignores,
+		Aliases:           bodyAliases,
+		Ignores:           explorerResource.SchemaOptions.Ignores,
+		UniqueItemsAsSets: setOverrides,
 	}
-	globalSchemaOpts := oas.GlobalSchemaOpts{
-		OverrideComputability: schema.Computed,
-	}
-	createResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.CreateOp, schemaOpts, globalSchemaOpts)
+	responseSchemaOpts := globalSchemaOpts
+	responseSchemaOpts.OverrideComputability = schema.Computed
+	createResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.CreateOp, schemaOpts, responseSchemaOpts)
 	if err != nil {
 		if errors.Is(err, oas.ErrSchemaNotFound) {
 			// Demote log to INFO if there was no schema found
@@ -117,12 +152,11 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 	readResponseAttributes := attrmapper.ResourceAttributes{}
 
 	schemaOpts = oas.SchemaOpts{
-		Ignores: explorerResource.SchemaOptions.Ignores,
-	}
-	globalSchemaOpts = oas.GlobalSchemaOpts{
-		OverrideComputability: schema.Computed,
+		Aliases:           bodyAliases,
+		Ignores:           explorerResource.SchemaOptions.Ignores,
+		UniqueItemsAsSets: setOverrides,
 	}
-	readResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.ReadOp, schemaOpts, globalSchemaOpts)
+	readResponseSchema, err := oas.BuildSchemaFromResponse(explorerResource.ReadOp, schemaOpts, responseSchemaOpts)
 	if err != nil {
 		if errors.Is(err, oas.ErrSchemaNotFound) {
 			// Demote log to INFO if there was no schema found
@@ -150,25 +184,27 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 		schemaOpts := oas.SchemaOpts{
 			Ignores:             explorerResource.SchemaOptions.Ignores,
 			OverrideDescription: param.Description,
+			UniqueItemsAsSets:   setOverrides,
 		}
-		globalSchemaOpts := oas.GlobalSchemaOpts{OverrideComputability: schema.ComputedOptional}
+		paramSchemaOpts := globalSchemaOpts
+		paramSchemaOpts.OverrideComputability = schema.ComputedOptional
 
-		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, globalSchemaOpts)
+		s, schemaErr := oas.BuildSchema(param.Schema, schemaOpts, paramSchemaOpts)
 		if schemaErr != nil {
 			log.WarnLogOnError(pLogger, schemaErr, "skipping mapping of read operation parameter")
 			continue
 		}
 
 		// Check for any aliases and replace the paramater name if found
-		paramName := param.Name
-		if aliasedName, ok := explorerResource.SchemaOptions.AttributeOptions.Aliases[param.Name]; ok {
-			pLogger = pLogger.With("param_alias", aliasedName)
-			paramName = aliasedName
+		paramName := parameterAttributeName(param, explorerResource.SchemaOptions.AttributeOptions.Aliases)
+		if paramName != param.Name {
+			pLogger = pLogger.With("param_alias", paramName)
 		}
 
 		if s.IsPropertyIgnored(paramName) {
 			continue
 		}
+		s.SchemaOpts.UniqueItemsAsSet = s.GetUniqueItemsAsSet(paramName)
 
 		parameterAttribute, schemaErr := s.BuildResourceAttribute(paramName, schema.ComputedOptional)
 		if schemaErr != nil {
@@ -182,9 +218,5 @@ func generateResourceSchema(logger *slog.Logger, explorerResource explorer.Resou
 	// TODO: currently, no errors can be returned from merging, but in the future we should consider raising errors/warnings for unexpected scenarios, like type mismatches between attribute schemas
 	resourceAttributes, _ := createRequestAttributes.Merge(createResponseAttributes, readResponseAttributes, readParameterAttributes)
 
-	// TODO: handle error for overrides
-	resourceAttributes, _ = resourceAttributes.ApplyOverrides(explorerResource.SchemaOptions.AttributeOptions.Overrides)
-
-	resourceSchema.Attributes = resourceAttributes.ToSpec()
-	return resourceSchema, nil
+	return resourceAttributes, nil
 }
@@ -4,21 +4,27 @@
 package mapper_test
 
 import (
+	"bytes"
+	"io"
 	"log/slog"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-codegen-spec/code"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/schema"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/frameworkvalidators"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pb33f/libopenapi/datamodel/high/base"
 	high "github.com/pb33f/libopenapi/datamodel/high/v3"
 	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
 )
 
 func TestResourceMapper_basic_merges(t *testing.T) {
@@ -1065,6 +1071,1026 @@ func TestResourceMapper_basic_merges(t *testing.T) {
 	}
 }
 
+func TestResourceMapper_format_custom_types(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"created_at", "id", "ip_address"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"created_at": base.CreateSchemaProxy(&base.Schema{
+				Type:   []string{"string"},
+				Format: "date-time",
+			}),
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type:   []string{"string"},
+				Format: "uuid",
+			}),
+			"ip_address": base.CreateSchemaProxy(&base.Schema{
+				Type:   []string{"string"},
+				Format: "ipv4",
+			}),
+		}),
+	})
+
+	testCases := map[string]struct {
+		formats map[string]config.Format
+		want    resource.Attributes
+	}{
+		"default custom types": {
+			want: resource.Attributes{
+				{
+					Name: "created_at",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						CustomType: &schema.CustomType{
+							Import:    &code.Import{Path: "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"},
+							Type:      "timetypes.RFC3339Type{}",
+							ValueType: "timetypes.RFC3339",
+						},
+					},
+				},
+				{
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						Validators: schema.StringValidators{
+							{
+								Custom: frameworkvalidators.StringValidatorFormat("uuid"),
+							},
+						},
+					},
+				},
+				{
+					Name: "ip_address",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						CustomType: &schema.CustomType{
+							Import:    &code.Import{Path: "github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"},
+							Type:      "iptypes.IPv4AddressType{}",
+							ValueType: "iptypes.IPv4Address",
+						},
+					},
+				},
+			},
+		},
+		"configured custom types": {
+			formats: map[string]config.Format{
+				"date-time": {
+					CustomType: &config.CustomType{},
+				},
+				"uuid": {
+					CustomType: &config.CustomType{
+						Import:    "github.com/example/uuidtypes",
+						Type:      "uuidtypes.UUIDType{}",
+						ValueType: "uuidtypes.UUID",
+					},
+				},
+				"ipv4": {},
+			},
+			want: resource.Attributes{
+				{
+					Name: "created_at",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+					},
+				},
+				{
+					// The format validator is skipped, as the custom type for the format is expected to validate the value
+					Name: "id",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						CustomType: &schema.CustomType{
+							Import:    &code.Import{Path: "github.com/example/uuidtypes"},
+							Type:      "uuidtypes.UUIDType{}",
+							ValueType: "uuidtypes.UUID",
+						},
+					},
+				},
+				{
+					Name: "ip_address",
+					String: &resource.StringAttribute{
+						ComputedOptionalRequired: schema.Required,
+						CustomType: &schema.CustomType{
+							Import:    &code.Import{Path: "github.com/hashicorp/terraform-plugin-framework-nettypes/iptypes"},
+							Type:      "iptypes.IPv4AddressType{}",
+							ValueType: "iptypes.IPv4Address",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(createRequestSchema, nil),
+					ReadOp:   createTestReadOp(nil, nil),
+				},
+			}, config.Config{Formats: testCase.formats})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_unique_items_as_sets(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"spec", "tags"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"spec": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"steps": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"array"},
+						UniqueItems: pointer(true),
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						},
+					}),
+				}),
+			}),
+			"tags": base.CreateSchemaProxy(&base.Schema{
+				Type:        []string{"array"},
+				UniqueItems: pointer(true),
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			}),
+		}),
+	})
+
+	listAttribute := func(name string, computability schema.ComputedOptionalRequired) resource.Attribute {
+		return resource.Attribute{
+			Name: name,
+			List: &resource.ListAttribute{
+				ElementType: schema.ElementType{
+					String: &schema.StringType{},
+				},
+				ComputedOptionalRequired: computability,
+				Validators: []schema.ListValidator{
+					{
+						Custom: frameworkvalidators.ListValidatorUniqueValues(),
+					},
+				},
+			},
+		}
+	}
+
+	setAttribute := func(name string, computability schema.ComputedOptionalRequired) resource.Attribute {
+		return resource.Attribute{
+			Name: name,
+			Set: &resource.SetAttribute{
+				ElementType: schema.ElementType{
+					String: &schema.StringType{},
+				},
+				ComputedOptionalRequired: computability,
+			},
+		}
+	}
+
+	specAttribute := func(steps resource.Attribute) resource.Attribute {
+		return resource.Attribute{
+			Name: "spec",
+			SingleNested: &resource.SingleNestedAttribute{
+				Attributes:               resource.Attributes{steps},
+				ComputedOptionalRequired: schema.Required,
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		uniqueItemsAsSets bool
+		overrides         map[string]explorer.Override
+		want              resource.Attributes
+	}{
+		"default": {
+			want: resource.Attributes{
+				specAttribute(listAttribute("steps", schema.ComputedOptional)),
+				listAttribute("tags", schema.Required),
+			},
+		},
+		"unique items as sets": {
+			uniqueItemsAsSets: true,
+			want: resource.Attributes{
+				specAttribute(setAttribute("steps", schema.ComputedOptional)),
+				setAttribute("tags", schema.Required),
+			},
+		},
+		"unique items as sets, overridden to list": {
+			uniqueItemsAsSets: true,
+			overrides: map[string]explorer.Override{
+				"spec.steps": {
+					UniqueItemsAsSet: pointer(false),
+				},
+			},
+			want: resource.Attributes{
+				specAttribute(listAttribute("steps", schema.ComputedOptional)),
+				setAttribute("tags", schema.Required),
+			},
+		},
+		"overridden to set": {
+			overrides: map[string]explorer.Override{
+				"tags": {
+					UniqueItemsAsSet: pointer(true),
+				},
+			},
+			want: resource.Attributes{
+				specAttribute(listAttribute("steps", schema.ComputedOptional)),
+				setAttribute("tags", schema.Required),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(createRequestSchema, nil),
+					ReadOp:   createTestReadOp(nil, nil),
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: testCase.overrides,
+						},
+					},
+				},
+			}, config.Config{UniqueItemsAsSets: testCase.uniqueItemsAsSets})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_defaults(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"ports", "settings", "tags"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"ports": base.CreateSchemaProxy(&base.Schema{
+				Type:    []string{"array"},
+				Default: &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: "all"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				},
+			}),
+			"settings": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Default: &yaml.Node{
+					Kind: yaml.MappingNode,
+					Content: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Tag: "!!str", Value: "maxRetries"},
+						{Kind: yaml.ScalarNode, Tag: "!!int", Value: "3"},
+					},
+				},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"maxRetries": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				}),
+			}),
+			"tags": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"array"},
+				Default: &yaml.Node{
+					Kind: yaml.SequenceNode,
+					Content: []*yaml.Node{
+						{Kind: yaml.ScalarNode, Tag: "!!str", Value: "managed"},
+					},
+				},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				},
+			}),
+		}),
+	})
+
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"settings": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"maxRetries": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+					"status": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"string"},
+					}),
+				}),
+			}),
+		}),
+	})
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"test_resource": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(readResponseSchema, nil),
+		},
+	}, config.Config{})
+	got, err := mapper.MapToIR(slog.New(slog.NewTextHandler(io.Discard, nil)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected only one resource, got: %d", len(got))
+	}
+
+	want := resource.Attributes{
+		{
+			Name: "ports",
+			List: &resource.ListAttribute{
+				ElementType: schema.ElementType{
+					Int64: &schema.Int64Type{},
+				},
+				ComputedOptionalRequired: schema.Required,
+			},
+		},
+		{
+			Name: "settings",
+			SingleNested: &resource.SingleNestedAttribute{
+				Attributes: resource.Attributes{
+					{
+						Name: "max_retries",
+						Int64: &resource.Int64Attribute{
+							ComputedOptionalRequired: schema.ComputedOptional,
+						},
+					},
+					{
+						Name: "status",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Computed,
+						},
+					},
+				},
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Default: &schema.ObjectDefault{
+					Custom: &schema.CustomDefault{
+						Imports: []code.Import{
+							{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/objectdefault"},
+							{Path: "github.com/hashicorp/terraform-plugin-framework/types"},
+							{Path: "github.com/hashicorp/terraform-plugin-framework/attr"},
+						},
+						SchemaDefinition: "objectdefault.StaticValue(types.ObjectValueMust(map[string]attr.Type{\n" +
+							"\"max_retries\": types.Int64Type,\n" +
+							"\"status\": types.StringType,\n" +
+							"}, map[string]attr.Value{\n" +
+							"\"max_retries\": types.Int64Value(3),\n" +
+							"\"status\": types.StringNull(),\n" +
+							"}))",
+					},
+				},
+			},
+		},
+		{
+			Name: "tags",
+			List: &resource.ListAttribute{
+				ElementType: schema.ElementType{
+					String: &schema.StringType{},
+				},
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Default: &schema.ListDefault{
+					Custom: &schema.CustomDefault{
+						Imports: []code.Import{
+							{Path: "github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"},
+							{Path: "github.com/hashicorp/terraform-plugin-framework/attr"},
+							{Path: "github.com/hashicorp/terraform-plugin-framework/types"},
+						},
+						SchemaDefinition: "listdefault.StaticValue(types.ListValueMust(types.StringType, []attr.Value{\n" +
+							"types.StringValue(\"managed\"),\n" +
+							"}))",
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got[0].Schema.Attributes, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceMapper_defaults_custom_type(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"rules"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"rules": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"array"},
+				Default: &yaml.Node{
+					Kind: yaml.SequenceNode,
+					Content: []*yaml.Node{
+						{
+							Kind: yaml.MappingNode,
+							Content: []*yaml.Node{
+								{Kind: yaml.ScalarNode, Tag: "!!str", Value: "name"},
+								{Kind: yaml.ScalarNode, Tag: "!!str", Value: "all"},
+							},
+						},
+					},
+				},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"name": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+				},
+			}),
+		}),
+	})
+
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"rules": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"created_at": base.CreateSchemaProxy(&base.Schema{
+								Type:     []string{"string"},
+								Format:   "date-time",
+								ReadOnly: pointer(true),
+							}),
+							"name": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}),
+				},
+			}),
+		}),
+	})
+
+	var logs bytes.Buffer
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"test_resource": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(readResponseSchema, nil),
+		},
+	}, config.Config{})
+	got, err := mapper.MapToIR(slog.New(slog.NewTextHandler(&logs, nil)))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if len(got) != 1 {
+		t.Fatalf("expected only one resource, got: %d", len(got))
+	}
+
+	// The default can't be mapped once the read-only attribute with a custom type is merged into the nested object, so the
+	// attribute stays required
+	want := resource.Attributes{
+		{
+			Name: "rules",
+			ListNested: &resource.ListNestedAttribute{
+				NestedObject: resource.NestedAttributeObject{
+					Attributes: resource.Attributes{
+						{
+							Name: "name",
+							String: &resource.StringAttribute{
+								ComputedOptionalRequired: schema.ComputedOptional,
+							},
+						},
+						{
+							Name: "created_at",
+							String: &resource.StringAttribute{
+								ComputedOptionalRequired: schema.Computed,
+								CustomType: &schema.CustomType{
+									Import:    &code.Import{Path: "github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"},
+									Type:      "timetypes.RFC3339Type{}",
+									ValueType: "timetypes.RFC3339",
+								},
+							},
+						},
+					},
+				},
+				ComputedOptionalRequired: schema.Required,
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got[0].Schema.Attributes, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+
+	if !strings.Contains(logs.String(), "default of attribute 'rules' doesn't match the type of the attribute: attribute 'created_at': values of custom types are not supported") {
+		t.Errorf("expected a warning for the ignored default, got logs: %s", logs.String())
+	}
+}
+
+func TestResourceMapper_ignore_const_attributes(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"api_version", "name"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"api_version": base.CreateSchemaProxy(&base.Schema{
+				Type:  []string{"string"},
+				Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "v1"},
+			}),
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	readParams := []*high.Parameter{
+		{
+			Name:     "kind",
+			Required: pointer(true),
+			In:       "query",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+				Enum: []*yaml.Node{
+					{Kind: yaml.ScalarNode, Value: "pet"},
+				},
+			}),
+		},
+		{
+			Name:     "region",
+			Required: pointer(true),
+			In:       "path",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type:  []string{"string"},
+				Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "global"},
+			}),
+		},
+	}
+
+	nameAttribute := resource.Attribute{
+		Name: "name",
+		String: &resource.StringAttribute{
+			ComputedOptionalRequired: schema.Required,
+		},
+	}
+
+	constAttribute := func(name string, value string) resource.Attribute {
+		return resource.Attribute{
+			Name: name,
+			String: &resource.StringAttribute{
+				ComputedOptionalRequired: schema.ComputedOptional,
+				Default: &schema.StringDefault{
+					Static: pointer(value),
+				},
+				Validators: []schema.StringValidator{
+					{
+						Custom: &schema.CustomValidator{
+							Imports: []code.Import{
+								{
+									Path: "github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator",
+								},
+							},
+							SchemaDefinition: "stringvalidator.OneOf(\n\"" + value + "\",\n)",
+						},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		ignoreConstAttributes bool
+		want                  resource.Attributes
+	}{
+		"default": {
+			want: resource.Attributes{
+				constAttribute("api_version", "v1"),
+				nameAttribute,
+				constAttribute("kind", "pet"),
+				constAttribute("region", "global"),
+			},
+		},
+		// Parameters are needed to locate the resource, so only body properties are ignored
+		"ignore const attributes": {
+			ignoreConstAttributes: true,
+			want: resource.Attributes{
+				nameAttribute,
+				constAttribute("kind", "pet"),
+				constAttribute("region", "global"),
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(createRequestSchema, nil),
+					ReadOp:   createTestReadOp(nil, readParams),
+				},
+			}, config.Config{IgnoreConstAttributes: testCase.ignoreConstAttributes})
+			got, err := mapper.MapToIR(slog.Default())
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if len(got) != 1 {
+				t.Fatalf("expected only one resource, got: %d", len(got))
+			}
+
+			if diff := cmp.Diff(got[0].Schema.Attributes, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestResourceMapper_schema_defaults(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"etag": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	cfg := config.Config{
+		Defaults: config.SchemaOptions{
+			Ignores: []string{"**.etag"},
+			AttributeOptions: config.AttributeOptions{
+				Overrides: map[string]config.Override{
+					"name": {
+						Description: "default description",
+					},
+				},
+			},
+		},
+	}
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"with_defaults": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(nil, nil),
+		},
+		"with_excluded_defaults": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(nil, nil),
+			SchemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"name": {
+							Description: "resource description",
+						},
+					},
+				},
+				ExcludeDefaults: []string{"**.etag"},
+			},
+		},
+	}, cfg)
+	got, err := mapper.MapToIR(slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []resource.Resource{
+		{
+			Name: "with_defaults",
+			Schema: &resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "name",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Required,
+							Description:              pointer("default description"),
+						},
+					},
+				},
+			},
+		},
+		{
+			Name: "with_excluded_defaults",
+			Schema: &resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "etag",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.ComputedOptional,
+						},
+					},
+					{
+						Name: "name",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Required,
+							Description:              pointer("resource description"),
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceMapper_body_aliases(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"displayName", "spec"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"displayName": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"spec": base.CreateSchemaProxy(&base.Schema{
+				Type:     []string{"object"},
+				Required: []string{"replicas"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"replicas": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"integer"},
+					}),
+				}),
+			}),
+		}),
+	})
+
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"spec": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"replicaCount": base.CreateSchemaProxy(&base.Schema{
+						Type:        []string{"integer"},
+						Description: "The number of replicas.",
+					}),
+				}),
+			}),
+		}),
+	})
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"deployment": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(readResponseSchema, nil),
+			SchemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"displayName":       "name",
+						"spec.replicas":     "replica_count",
+						"spec.replicaCount": "replica_count",
+					},
+					Overrides: map[string]explorer.Override{
+						"spec.replica_count": {
+							Description: "The desired number of replicas.",
+						},
+					},
+				},
+			},
+		},
+	}, config.Config{})
+	got, err := mapper.MapToIR(slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	want := []resource.Resource{
+		{
+			Name: "deployment",
+			Schema: &resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "name",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Required,
+						},
+					},
+					{
+						Name: "spec",
+						SingleNested: &resource.SingleNestedAttribute{
+							Attributes: resource.Attributes{
+								{
+									Name: "replica_count",
+									Int64: &resource.Int64Attribute{
+										ComputedOptionalRequired: schema.Required,
+										Description:              pointer("The desired number of replicas."),
+									},
+								},
+							},
+							ComputedOptionalRequired: schema.Required,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceMapper_parameter_aliases(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type:     []string{"object"},
+		Required: []string{"name"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	readResponseSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"id": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	readParams := []*high.Parameter{
+		{
+			Name:     "id",
+			In:       "path",
+			Required: pointer(true),
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+	}
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"pet": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(readResponseSchema, readParams),
+			SchemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Aliases: map[string]string{
+						"id": "pet_id",
+					},
+				},
+			},
+		},
+	}, config.Config{})
+	got, err := mapper.MapToIR(slog.Default())
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	// The alias of the path parameter doesn't rename the response body property with the same name
+	want := []resource.Resource{
+		{
+			Name: "pet",
+			Schema: &resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "name",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Required,
+						},
+					},
+					{
+						Name: "id",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.Computed,
+						},
+					},
+					{
+						Name: "pet_id",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.ComputedOptional,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
+func TestResourceMapper_invalid_override(t *testing.T) {
+	t.Parallel()
+
+	createRequestSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"name": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		}),
+	})
+
+	mapper := mapper.NewResourceMapper(map[string]explorer.Resource{
+		"invalid_override": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(nil, nil),
+			SchemaOptions: explorer.SchemaOptions{
+				AttributeOptions: explorer.AttributeOptions{
+					Overrides: map[string]explorer.Override{
+						"name": {
+							Type: "set",
+						},
+					},
+				},
+			},
+		},
+		"valid": {
+			CreateOp: createTestCreateOp(createRequestSchema, nil),
+			ReadOp:   createTestReadOp(nil, nil),
+		},
+	}, config.Config{})
+	got, err := mapper.MapToIR(slog.Default())
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+
+	expectedErr := "resource 'invalid_override': invalid attribute override: attribute 'name' can't be overridden to type 'set', only list and set attributes can be converted"
+	if err.Error() != expectedErr {
+		t.Errorf("unexpected error, got: %s, wanted: %s", err, expectedErr)
+	}
+
+	want := []resource.Resource{
+		{
+			Name: "valid",
+			Schema: &resource.Schema{
+				Attributes: resource.Attributes{
+					{
+						Name: "name",
+						String: &resource.StringAttribute{
+							ComputedOptionalRequired: schema.ComputedOptional,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
+
 func createTestCreateOp(request *base.SchemaProxy, response *base.SchemaProxy) *high.Operation {
 	return &high.Operation{
 		RequestBody: &high.RequestBody{
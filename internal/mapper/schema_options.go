@@ -0,0 +1,405 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/oas"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/suggest"
+
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+)
+
+const (
+	SchemaOptionIgnore   = "ignore"
+	SchemaOptionOverride = "override"
+	SchemaOptionAlias    = "alias"
+
+	ObjectTypeResource   = "resource"
+	ObjectTypeDataSource = "data_source"
+	ObjectTypeProvider   = "provider"
+	ObjectTypeDefaults   = "defaults"
+)
+
+// SchemaOptionMismatch is an ignore, override, or alias in the generator config that doesn't match any attribute or parameter
+// mapped from the OpenAPI spec. Ignores and overrides with a location pattern are mismatched if the pattern doesn't match any
+// attribute.
+type SchemaOptionMismatch struct {
+	// ObjectType is the type of object the schema option is defined on: resource, data_source, provider, or defaults.
+	ObjectType string
+	// ObjectName is the name of the resource or data source. Empty for the provider and defaults.
+	ObjectName string
+	// Option is the kind of schema option: ignore, override, or alias.
+	Option string
+	// Value is the ignore location, override location, or aliased parameter name as written in the generator config.
+	Value string
+	// Suggestions are similar attribute locations or parameter names that exist in the OpenAPI spec.
+	Suggestions []string
+}
+
+func (m SchemaOptionMismatch) Error() string {
+	object := m.ObjectType
+	if m.ObjectName != "" {
+		object = fmt.Sprintf("%s '%s'", m.ObjectType, m.ObjectName)
+	}
+
+	target := "attribute"
+	if m.Option == SchemaOptionAlias {
+		target = "parameter or property"
+	}
+
+	msg := fmt.Sprintf("%s %s '%s' doesn't match any %s", object, m.Option, m.Value, target)
+	if len(m.Suggestions) > 0 {
+		msg += fmt.Sprintf(", did you mean: '%s'?", strings.Join(m.Suggestions, "', '"))
+	}
+
+	return msg
+}
+
+// FindSchemaOptionMismatches maps all explored resources, data sources, and the provider, and returns every ignore, override,
+// and alias that doesn't match an attribute or parameter. Ignores are checked against all attributes, overrides are checked
+// against the attributes remaining after ignores are applied, and aliases are checked against the read operation parameters
+// and the properties of the request and response bodies. Defaults are merged into every resource and data source, and are only
+// mismatched if they don't match any resource or data source they are applied to.
+//
+// Objects that can't be mapped are skipped, as those errors are reported when generating the provider code spec.
+func FindSchemaOptionMismatches(resources map[string]explorer.Resource, dataSources map[string]explorer.DataSource, provider explorer.Provider, cfg config.Config) []SchemaOptionMismatch {
+	// Mapping warnings are logged when generating the provider code spec, so they are discarded here
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	globalSchemaOpts := newGlobalSchemaOpts(cfg)
+	defaults := explorer.SchemaDefaults(cfg)
+	mismatches := []SchemaOptionMismatch{}
+	defaultsMismatches := newDefaultsMismatchFinder(defaults)
+
+	for _, name := range util.SortedKeys(resources) {
+		explorerResource := resources[name]
+		schemaOptions := explorerResource.SchemaOptions
+		explorerResource.SchemaOptions = schemaOptions.WithDefaults(defaults)
+
+		unmodifiedResource := explorerResource
+		unmodifiedResource.SchemaOptions.Ignores = nil
+		unmodifiedResource.SchemaOptions.AttributeOptions.Aliases = nil
+		attributes, err := generateResourceAttributes(logger, unmodifiedResource, globalSchemaOpts)
+		if err != nil {
+			continue
+		}
+
+		candidates := newSchemaOptionCandidates(explorerResource.ReadOpParameters(), attributes.Paths(), attributes.IgnorePaths(), explorerResource.SchemaOptions)
+
+		mismatches = append(mismatches, findMismatches(
+			SchemaOptionMismatch{ObjectType: ObjectTypeResource, ObjectName: name},
+			schemaOptions,
+			candidates,
+		)...)
+		defaultsMismatches.find(schemaOptions.ExcludeDefaults, candidates)
+	}
+
+	for _, name := range util.SortedKeys(dataSources) {
+		dataSource := dataSources[name]
+		schemaOptions := dataSource.SchemaOptions
+		dataSource.SchemaOptions = schemaOptions.WithDefaults(defaults)
+
+		unmodifiedDataSource := dataSource
+		unmodifiedDataSource.SchemaOptions.Ignores = nil
+		unmodifiedDataSource.SchemaOptions.AttributeOptions.Aliases = nil
+		attributes, err := generateDataSourceAttributes(logger, name, unmodifiedDataSource, globalSchemaOpts)
+		if err != nil {
+			continue
+		}
+
+		candidates := newSchemaOptionCandidates(dataSource.ReadOpParameters(), attributes.Paths(), attributes.IgnorePaths(), dataSource.SchemaOptions)
+
+		mismatches = append(mismatches, findMismatches(
+			SchemaOptionMismatch{ObjectType: ObjectTypeDataSource, ObjectName: name},
+			schemaOptions,
+			candidates,
+		)...)
+		defaultsMismatches.find(schemaOptions.ExcludeDefaults, candidates)
+	}
+
+	mismatches = append(mismatches, defaultsMismatches.mismatches()...)
+
+	if len(provider.Ignores) > 0 {
+		candidates := schemaOptionCandidates{}
+		if provider.SchemaProxy != nil {
+			unignoredProvider := provider
+			unignoredProvider.Ignores = nil
+			allAttributes, err := generateProviderAttributes(logger, unignoredProvider, globalSchemaOpts)
+			if err != nil {
+				return mismatches
+			}
+			candidates.ignorePaths = allAttributes.IgnorePaths()
+		}
+
+		mismatches = append(mismatches, findMismatches(
+			SchemaOptionMismatch{ObjectType: ObjectTypeProvider},
+			explorer.SchemaOptions{Ignores: provider.Ignores},
+			candidates,
+		)...)
+	}
+
+	return mismatches
+}
+
+// schemaOptionCandidates are the attribute locations and parameter names that the schema options of a resource, data source,
+// or provider can match.
+type schemaOptionCandidates struct {
+	// ignorePaths are the locations of all attributes, with aliases applied.
+	ignorePaths []string
+	// overridePaths are the locations of the attributes remaining after ignores are applied, with aliases applied.
+	overridePaths []string
+	// aliasNames are the path and query parameter names, and the property locations before aliases are applied.
+	aliasNames []string
+}
+
+// newSchemaOptionCandidates returns the candidates for the schema options of a resource or data source, from the locations of its
+// attributes mapped without ignores and aliases. Each resource and data source is only mapped once, and the aliases and ignores
+// are applied to the attribute locations the same way they are applied when mapping.
+func newSchemaOptionCandidates(parameters []*high.Parameter, paths []string, ignorePaths []string, schemaOptions explorer.SchemaOptions) schemaOptionCandidates {
+	candidates := schemaOptionCandidates{
+		ignorePaths:   []string{},
+		overridePaths: []string{},
+		aliasNames:    aliasNames(parameters, ignorePaths),
+	}
+
+	aliasOpts := oas.SchemaOpts{
+		Aliases: propertyAliases(parameters, schemaOptions.AttributeOptions.Aliases),
+	}
+	ignoreOpts := oas.SchemaOpts{
+		Aliases: aliasOpts.Aliases,
+		Ignores: schemaOptions.Ignores,
+	}
+
+	// Aliases can merge multiple properties into one attribute, so the aliased locations can contain duplicates
+	seenIgnorePaths := map[string]bool{}
+	for _, ignorePath := range ignorePaths {
+		aliasedPath, _ := oas.AttributeLocation(ignorePath, aliasOpts)
+		if !seenIgnorePaths[aliasedPath] {
+			seenIgnorePaths[aliasedPath] = true
+			candidates.ignorePaths = append(candidates.ignorePaths, aliasedPath)
+		}
+	}
+
+	seenOverridePaths := map[string]bool{}
+	for _, path := range paths {
+		aliasedPath, ignored := oas.AttributeLocation(path, ignoreOpts)
+		if !seenOverridePaths[aliasedPath] && !ignored {
+			seenOverridePaths[aliasedPath] = true
+			candidates.overridePaths = append(candidates.overridePaths, aliasedPath)
+		}
+	}
+
+	// Aliased parameters are mapped to a separate attribute, as the body properties with the same name aren't aliased
+	for _, param := range parameters {
+		if param.In != util.OAS_param_path && param.In != util.OAS_param_query {
+			continue
+		}
+
+		paramName := parameterAttributeName(param, schemaOptions.AttributeOptions.Aliases)
+		if paramName == param.Name || !slices.Contains(ignorePaths, param.Name) {
+			continue
+		}
+
+		if !seenIgnorePaths[paramName] {
+			seenIgnorePaths[paramName] = true
+			candidates.ignorePaths = append(candidates.ignorePaths, paramName)
+		}
+
+		_, ignored := oas.AttributeLocation(paramName, oas.SchemaOpts{Ignores: schemaOptions.Ignores})
+		if !seenOverridePaths[paramName] && !ignored {
+			seenOverridePaths[paramName] = true
+			candidates.overridePaths = append(candidates.overridePaths, paramName)
+		}
+	}
+
+	return candidates
+}
+
+// parameterAttributeName returns the attribute name of a path or query parameter, which is the alias of the parameter if there is one,
+// otherwise the parameter name.
+func parameterAttributeName(param *high.Parameter, aliases map[string]string) string {
+	if alias, ok := aliases[param.Name]; ok {
+		return alias
+	}
+
+	return param.Name
+}
+
+// propertyAliases returns the aliases applied to request and response body properties. Aliases of path and query parameters are
+// only applied to the parameters, so a body property with the same name as an aliased parameter keeps its name.
+func propertyAliases(parameters []*high.Parameter, aliases map[string]string) map[string]string {
+	if len(aliases) == 0 {
+		return aliases
+	}
+
+	result := make(map[string]string, len(aliases))
+	for location, alias := range aliases {
+		result[location] = alias
+	}
+
+	for _, param := range parameters {
+		if param.In == util.OAS_param_path || param.In == util.OAS_param_query {
+			delete(result, param.Name)
+		}
+	}
+
+	return result
+}
+
+// aliasNames returns the names that can be aliased: path and query parameter names, followed by all other property locations.
+// Property locations include the parameters, as they are mapped to attributes with the same name.
+func aliasNames(parameters []*high.Parameter, propertyPaths []string) []string {
+	names := []string{}
+	for _, param := range parameters {
+		if param.In == util.OAS_param_path || param.In == util.OAS_param_query {
+			names = append(names, param.Name)
+		}
+	}
+
+	for _, propertyPath := range propertyPaths {
+		if !slices.Contains(names, propertyPath) {
+			names = append(names, propertyPath)
+		}
+	}
+
+	return names
+}
+
+func findMismatches(object SchemaOptionMismatch, schemaOptions explorer.SchemaOptions, candidates schemaOptionCandidates) []SchemaOptionMismatch {
+	mismatches := []SchemaOptionMismatch{}
+
+	newMismatch := func(option string, value string, candidates []string) SchemaOptionMismatch {
+		mismatch := object
+		mismatch.Option = option
+		mismatch.Value = value
+		mismatch.Suggestions = suggest.Similar(candidates, value)
+		return mismatch
+	}
+
+	for _, ignore := range schemaOptions.Ignores {
+		if !matchesAnyLocation(ignore, candidates.ignorePaths) {
+			mismatches = append(mismatches, newMismatch(SchemaOptionIgnore, ignore, candidates.ignorePaths))
+		}
+	}
+
+	for _, override := range util.SortedKeys(schemaOptions.AttributeOptions.Overrides) {
+		if !matchesAnyLocation(override, candidates.overridePaths) {
+			mismatches = append(mismatches, newMismatch(SchemaOptionOverride, override, candidates.overridePaths))
+		}
+	}
+
+	// Aliases are applied to path and query parameters, and request and response body properties
+	for _, alias := range util.SortedKeys(schemaOptions.AttributeOptions.Aliases) {
+		if !slices.Contains(candidates.aliasNames, alias) {
+			mismatches = append(mismatches, newMismatch(SchemaOptionAlias, alias, candidates.aliasNames))
+		}
+	}
+
+	return mismatches
+}
+
+// defaultsMismatchFinder finds the defaults that don't match any resource or data source they are applied to.
+type defaultsMismatchFinder struct {
+	defaults explorer.SchemaOptions
+	// matched contains the defaults that matched at least one resource or data source.
+	matched map[defaultsKey]bool
+	// unmatched contains the first mismatch found for each default, to report suggestions.
+	unmatched map[defaultsKey]SchemaOptionMismatch
+}
+
+// defaultsKey identifies an ignore, override, or alias in the defaults.
+type defaultsKey struct {
+	option string
+	value  string
+}
+
+func newDefaultsMismatchFinder(defaults explorer.SchemaOptions) *defaultsMismatchFinder {
+	return &defaultsMismatchFinder{
+		defaults:  defaults,
+		matched:   map[defaultsKey]bool{},
+		unmatched: map[defaultsKey]SchemaOptionMismatch{},
+	}
+}
+
+// find checks the defaults that are applied to a resource or data source, which are all defaults that aren't excluded.
+func (f *defaultsMismatchFinder) find(excludeDefaults []string, candidates schemaOptionCandidates) {
+	appliedDefaults := explorer.SchemaOptions{ExcludeDefaults: excludeDefaults}.WithDefaults(f.defaults)
+
+	mismatched := map[defaultsKey]bool{}
+	for _, mismatch := range findMismatches(SchemaOptionMismatch{ObjectType: ObjectTypeDefaults}, appliedDefaults, candidates) {
+		key := defaultsKey{option: mismatch.Option, value: mismatch.Value}
+		mismatched[key] = true
+
+		if _, ok := f.unmatched[key]; !ok {
+			f.unmatched[key] = mismatch
+		}
+	}
+
+	for _, key := range defaultsKeys(appliedDefaults) {
+		if !mismatched[key] {
+			f.matched[key] = true
+		}
+	}
+}
+
+// mismatches returns the defaults that didn't match any resource or data source, including defaults that were excluded from
+// every resource and data source.
+func (f *defaultsMismatchFinder) mismatches() []SchemaOptionMismatch {
+	mismatches := []SchemaOptionMismatch{}
+
+	for _, key := range defaultsKeys(f.defaults) {
+		if f.matched[key] {
+			continue
+		}
+
+		mismatch, ok := f.unmatched[key]
+		if !ok {
+			mismatch = SchemaOptionMismatch{
+				ObjectType:  ObjectTypeDefaults,
+				Option:      key.option,
+				Value:       key.value,
+				Suggestions: []string{},
+			}
+		}
+
+		mismatches = append(mismatches, mismatch)
+	}
+
+	return mismatches
+}
+
+// defaultsKeys returns the key of every ignore, override, and alias of the schema options, in the order they are reported.
+func defaultsKeys(schemaOptions explorer.SchemaOptions) []defaultsKey {
+	keys := []defaultsKey{}
+
+	for _, ignore := range schemaOptions.Ignores {
+		keys = append(keys, defaultsKey{option: SchemaOptionIgnore, value: ignore})
+	}
+
+	for _, override := range util.SortedKeys(schemaOptions.AttributeOptions.Overrides) {
+		keys = append(keys, defaultsKey{option: SchemaOptionOverride, value: override})
+	}
+
+	for _, alias := range util.SortedKeys(schemaOptions.AttributeOptions.Aliases) {
+		keys = append(keys, defaultsKey{option: SchemaOptionAlias, value: alias})
+	}
+
+	return keys
+}
+
+// matchesAnyLocation checks if an ignore or override location, which can be a pattern like `**.etag`, matches any of the
+// attribute locations.
+func matchesAnyLocation(pattern string, locations []string) bool {
+	return slices.ContainsFunc(locations, func(location string) bool {
+		return util.MatchLocation(pattern, location)
+	})
+}
@@ -0,0 +1,468 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	high "github.com/pb33f/libopenapi/datamodel/high/v3"
+	"github.com/pb33f/libopenapi/orderedmap"
+	"gopkg.in/yaml.v3"
+)
+
+func TestFindSchemaOptionMismatches(t *testing.T) {
+	t.Parallel()
+
+	testSchema := base.CreateSchemaProxy(&base.Schema{
+		Type: []string{"object"},
+		Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+			"string_prop": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+			"nested_obj": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"object"},
+				Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+					"bool_prop": base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"boolean"},
+					}),
+				}),
+			}),
+			"list_of_objects": base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"array"},
+				Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+					A: base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"array"},
+						Items: &base.DynamicValue[*base.SchemaProxy, bool]{
+							A: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"object"},
+								Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+									"elem_prop": base.CreateSchemaProxy(&base.Schema{
+										Type: []string{"string"},
+									}),
+								}),
+							}),
+						},
+					}),
+				},
+			}),
+		}),
+	})
+	testParams := []*high.Parameter{
+		{
+			Name: "resource_id",
+			In:   "path",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+		{
+			Name: "X-Header",
+			In:   "header",
+			Schema: base.CreateSchemaProxy(&base.Schema{
+				Type: []string{"string"},
+			}),
+		},
+	}
+
+	testCases := map[string]struct {
+		resources   map[string]explorer.Resource
+		dataSources map[string]explorer.DataSource
+		provider    explorer.Provider
+		cfg         config.Config
+		want        []mapper.SchemaOptionMismatch
+	}{
+		"all schema options match": {
+			resources: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(testSchema, nil),
+					ReadOp:   createTestReadOp(nil, testParams),
+					SchemaOptions: explorer.SchemaOptions{
+						Ignores: []string{"nested_obj.bool_prop", "list_of_objects.elem_prop"},
+						AttributeOptions: explorer.AttributeOptions{
+							Aliases: map[string]string{
+								"resource_id": "id",
+							},
+							Overrides: map[string]explorer.Override{
+								"string_prop": {Description: "overridden"},
+							},
+						},
+					},
+				},
+			},
+			dataSources: map[string]explorer.DataSource{
+				"test_data_source": {
+					ReadOp: createTestReadOp(testSchema, testParams),
+					SchemaOptions: explorer.SchemaOptions{
+						Ignores: []string{"string_prop"},
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{
+								"nested_obj.bool_prop": {Description: "overridden"},
+							},
+						},
+					},
+				},
+			},
+			provider: explorer.Provider{
+				Name:        "test_provider",
+				SchemaProxy: testSchema,
+				Ignores:     []string{"nested_obj"},
+			},
+			want: []mapper.SchemaOptionMismatch{},
+		},
+		"mismatched schema options": {
+			resources: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(testSchema, nil),
+					ReadOp:   createTestReadOp(nil, testParams),
+					SchemaOptions: explorer.SchemaOptions{
+						Ignores: []string{"nested_obj.bool_prp"},
+						AttributeOptions: explorer.AttributeOptions{
+							Aliases: map[string]string{
+								"resource_idd": "id",
+								"X-Header":     "header",
+							},
+							Overrides: map[string]explorer.Override{
+								"list_of_objects.elem_prop": {Description: "overridden"},
+							},
+						},
+					},
+				},
+			},
+			dataSources: map[string]explorer.DataSource{
+				"test_data_source": {
+					ReadOp: createTestReadOp(testSchema, testParams),
+					SchemaOptions: explorer.SchemaOptions{
+						Ignores: []string{"string_prop"},
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{
+								"string_prop": {Description: "ignored, so can't be overridden"},
+							},
+						},
+					},
+				},
+			},
+			provider: explorer.Provider{
+				Name:        "test_provider",
+				SchemaProxy: testSchema,
+				Ignores:     []string{"doesnt_exist"},
+			},
+			want: []mapper.SchemaOptionMismatch{
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionIgnore,
+					Value:       "nested_obj.bool_prp",
+					Suggestions: []string{"nested_obj.bool_prop", "nested_obj"},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionOverride,
+					Value:       "list_of_objects.elem_prop",
+					Suggestions: []string{"list_of_objects"},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionAlias,
+					Value:       "X-Header",
+					Suggestions: []string{},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionAlias,
+					Value:       "resource_idd",
+					Suggestions: []string{"resource_id"},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeDataSource,
+					ObjectName:  "test_data_source",
+					Option:      mapper.SchemaOptionOverride,
+					Value:       "string_prop",
+					Suggestions: []string{},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeProvider,
+					Option:      mapper.SchemaOptionIgnore,
+					Value:       "doesnt_exist",
+					Suggestions: []string{},
+				},
+			},
+		},
+		"schema option patterns": {
+			resources: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(testSchema, nil),
+					ReadOp:   createTestReadOp(nil, testParams),
+					SchemaOptions: explorer.SchemaOptions{
+						Ignores: []string{"**.bool_prop", "*.elem_prop", "**.etag"},
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{
+								"*_prop":        {Description: "overridden"},
+								"*.string_prop": {Description: "overridden"},
+							},
+						},
+					},
+				},
+			},
+			want: []mapper.SchemaOptionMismatch{
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionIgnore,
+					Value:       "**.etag",
+					Suggestions: []string{},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionOverride,
+					Value:       "*.string_prop",
+					Suggestions: []string{"string_prop"},
+				},
+			},
+		},
+		"body property aliases": {
+			resources: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(testSchema, nil),
+					ReadOp:   createTestReadOp(nil, testParams),
+					SchemaOptions: explorer.SchemaOptions{
+						Ignores: []string{"str"},
+						AttributeOptions: explorer.AttributeOptions{
+							Aliases: map[string]string{
+								"string_prop":          "str",
+								"nested_obj.bool_prop": "enabled",
+								"nested_obj.bool_prp":  "enabled",
+								"nested_obj.enabled":   "is_enabled",
+							},
+							Overrides: map[string]explorer.Override{
+								"nested_obj.enabled": {Description: "overridden"},
+							},
+						},
+					},
+				},
+			},
+			want: []mapper.SchemaOptionMismatch{
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionAlias,
+					Value:       "nested_obj.bool_prp",
+					Suggestions: []string{"nested_obj.bool_prop", "nested_obj"},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionAlias,
+					Value:       "nested_obj.enabled",
+					Suggestions: []string{"nested_obj"},
+				},
+			},
+		},
+		"parameter aliases": {
+			resources: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(testSchema, nil),
+					ReadOp: createTestReadOp(nil, []*high.Parameter{
+						{
+							Name: "string_prop",
+							In:   "path",
+							Schema: base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						},
+					}),
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							// The parameter is aliased, the body property with the same name isn't
+							Aliases: map[string]string{
+								"string_prop": "str",
+							},
+							Overrides: map[string]explorer.Override{
+								"string_prop": {Description: "overridden"},
+								"str":         {Description: "overridden"},
+							},
+						},
+					},
+				},
+			},
+			want: []mapper.SchemaOptionMismatch{},
+		},
+		"defaults": {
+			resources: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(testSchema, nil),
+					ReadOp:   createTestReadOp(nil, testParams),
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{
+								"string_prop": {Description: "ignored by defaults, so can't be overridden"},
+							},
+						},
+						ExcludeDefaults: []string{"nested_obj.bool_prop"},
+					},
+				},
+			},
+			dataSources: map[string]explorer.DataSource{
+				"test_data_source": {
+					ReadOp: createTestReadOp(testSchema, testParams),
+					SchemaOptions: explorer.SchemaOptions{
+						ExcludeDefaults: []string{"nested_obj.bool_prop", "string_prop"},
+					},
+				},
+			},
+			cfg: config.Config{
+				Defaults: config.SchemaOptions{
+					Ignores: []string{"string_prop", "**.etag", "nested_obj.bool_prop"},
+					AttributeOptions: config.AttributeOptions{
+						Aliases: map[string]string{
+							"resource_id": "id",
+							"X-Header":    "header",
+						},
+						Overrides: map[string]config.Override{
+							"*_prop": {Description: "overridden"},
+						},
+					},
+				},
+			},
+			want: []mapper.SchemaOptionMismatch{
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionOverride,
+					Value:       "string_prop",
+					Suggestions: []string{},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeDefaults,
+					Option:      mapper.SchemaOptionIgnore,
+					Value:       "**.etag",
+					Suggestions: []string{},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeDefaults,
+					Option:      mapper.SchemaOptionIgnore,
+					Value:       "nested_obj.bool_prop",
+					Suggestions: []string{},
+				},
+				{
+					ObjectType:  mapper.ObjectTypeDefaults,
+					Option:      mapper.SchemaOptionAlias,
+					Value:       "X-Header",
+					Suggestions: []string{},
+				},
+			},
+		},
+		"ignore const attributes": {
+			resources: map[string]explorer.Resource{
+				"test_resource": {
+					CreateOp: createTestCreateOp(base.CreateSchemaProxy(&base.Schema{
+						Type: []string{"object"},
+						Properties: orderedmap.ToOrderedMap(map[string]*base.SchemaProxy{
+							"kind": base.CreateSchemaProxy(&base.Schema{
+								Type:  []string{"string"},
+								Const: &yaml.Node{Kind: yaml.ScalarNode, Value: "pet"},
+							}),
+							"name": base.CreateSchemaProxy(&base.Schema{
+								Type: []string{"string"},
+							}),
+						}),
+					}), nil),
+					ReadOp: createTestReadOp(nil, nil),
+					SchemaOptions: explorer.SchemaOptions{
+						AttributeOptions: explorer.AttributeOptions{
+							Overrides: map[string]explorer.Override{
+								"kind": {Description: "removed by ignore_const_attributes"},
+								"name": {Description: "the name"},
+							},
+						},
+					},
+				},
+			},
+			cfg: config.Config{
+				IgnoreConstAttributes: true,
+			},
+			want: []mapper.SchemaOptionMismatch{
+				{
+					ObjectType:  mapper.ObjectTypeResource,
+					ObjectName:  "test_resource",
+					Option:      mapper.SchemaOptionOverride,
+					Value:       "kind",
+					Suggestions: []string{},
+				},
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := mapper.FindSchemaOptionMismatches(testCase.resources, testCase.dataSources, testCase.provider, testCase.cfg)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSchemaOptionMismatch_Error(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		mismatch mapper.SchemaOptionMismatch
+		want     string
+	}{
+		"resource override with suggestions": {
+			mismatch: mapper.SchemaOptionMismatch{
+				ObjectType:  mapper.ObjectTypeResource,
+				ObjectName:  "pet",
+				Option:      mapper.SchemaOptionOverride,
+				Value:       "nme",
+				Suggestions: []string{"name", "nmae"},
+			},
+			want: "resource 'pet' override 'nme' doesn't match any attribute, did you mean: 'name', 'nmae'?",
+		},
+		"data source alias without suggestions": {
+			mismatch: mapper.SchemaOptionMismatch{
+				ObjectType: mapper.ObjectTypeDataSource,
+				ObjectName: "pet",
+				Option:     mapper.SchemaOptionAlias,
+				Value:      "petId",
+			},
+			want: "data_source 'pet' alias 'petId' doesn't match any parameter or property",
+		},
+		"provider ignore": {
+			mismatch: mapper.SchemaOptionMismatch{
+				ObjectType: mapper.ObjectTypeProvider,
+				Option:     mapper.SchemaOptionIgnore,
+				Value:      "token",
+			},
+			want: "provider ignore 'token' doesn't match any attribute",
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := testCase.mismatch.Error()
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
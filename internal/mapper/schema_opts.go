@@ -0,0 +1,34 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+)
+
+// ErrInvalidOverride is returned when an attribute override in the generator config can't be applied to the attribute it matches.
+// Unlike mapping errors, which skip the resource or data source, invalid overrides fail the mapping.
+var ErrInvalidOverride = errors.New("invalid attribute override")
+
+// uniqueItemsAsSets returns the UniqueItemsAsSet option of all attribute overrides that have one, keyed by attribute location.
+// These options are applied when building schemas, as mapping an array to a set or a list can't be changed after mapping.
+func uniqueItemsAsSets(overrides map[string]explorer.Override) map[string]bool {
+	var result map[string]bool
+
+	for location, override := range overrides {
+		if override.UniqueItemsAsSet == nil {
+			continue
+		}
+
+		if result == nil {
+			result = make(map[string]bool)
+		}
+
+		result[location] = *override.UniqueItemsAsSet
+	}
+
+	return result
+}
@@ -0,0 +1,128 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package util
+
+import (
+	"slices"
+	"sort"
+	"strings"
+)
+
+const (
+	// LocationSeparator separates the attribute names of an attribute location, like `category.tags.name`.
+	LocationSeparator = "."
+	// LocationWildcard matches any characters in an attribute name, like `*.etag` or `created_*`.
+	LocationWildcard = "*"
+	// LocationRecursiveWildcard matches any number of nested attribute names, including none, like `**._links`.
+	LocationRecursiveWildcard = "**"
+)
+
+// IsLocationPattern checks if an attribute location from the generator config contains wildcards.
+func IsLocationPattern(location string) bool {
+	return strings.Contains(location, LocationWildcard)
+}
+
+// MatchLocation checks if a dot-separated attribute location matches a location pattern from the generator config. Locations
+// without wildcards only match themselves.
+func MatchLocation(pattern string, location string) bool {
+	return matchLocationParts(strings.Split(pattern, LocationSeparator), strings.Split(location, LocationSeparator))
+}
+
+func matchLocationParts(pattern []string, location []string) bool {
+	if len(pattern) == 0 {
+		return len(location) == 0
+	}
+
+	if pattern[0] == LocationRecursiveWildcard {
+		for i := 0; i <= len(location); i++ {
+			if matchLocationParts(pattern[1:], location[i:]) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	if len(location) == 0 {
+		return false
+	}
+
+	return matchLocationName(pattern[0], location[0]) && matchLocationParts(pattern[1:], location[1:])
+}
+
+// matchLocationName checks if a single attribute name matches a part of a location pattern, where `*` matches any characters.
+func matchLocationName(pattern string, name string) bool {
+	if pattern == LocationRecursiveWildcard {
+		return true
+	}
+
+	parts := strings.Split(pattern, LocationWildcard)
+	if len(parts) == 1 {
+		return pattern == name
+	}
+
+	prefix, suffix := parts[0], parts[len(parts)-1]
+	if !strings.HasPrefix(name, prefix) {
+		return false
+	}
+	name = name[len(prefix):]
+
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(name, part)
+		if i == -1 {
+			return false
+		}
+		name = name[i+len(part):]
+	}
+
+	return strings.HasSuffix(name, suffix)
+}
+
+// NestedLocationPatterns returns the location patterns that apply to the nested attributes of an attribute, with the attribute
+// name removed. A recursive wildcard can match the attribute name or nothing, so both possibilities are returned. If the pattern
+// doesn't apply to any nested attributes, returns nil.
+//   - NestedLocationPatterns("category.id", "category") = ["id"]
+//   - NestedLocationPatterns("*.etag", "category") = ["etag"]
+//   - NestedLocationPatterns("**.etag", "category") = ["**.etag"]
+//   - NestedLocationPatterns("**.category.id", "category") = ["**.category.id", "id"]
+func NestedLocationPatterns(pattern string, name string) []string {
+	var nestedPatterns []string
+
+	for _, parts := range nestedLocationParts(strings.Split(pattern, LocationSeparator), name) {
+		nestedPattern := strings.Join(parts, LocationSeparator)
+		if nestedPattern != "" && !slices.Contains(nestedPatterns, nestedPattern) {
+			nestedPatterns = append(nestedPatterns, nestedPattern)
+		}
+	}
+
+	return nestedPatterns
+}
+
+func nestedLocationParts(pattern []string, name string) [][]string {
+	if len(pattern) < 2 {
+		return nil
+	}
+
+	if pattern[0] == LocationRecursiveWildcard {
+		return append([][]string{pattern}, nestedLocationParts(pattern[1:], name)...)
+	}
+
+	if !matchLocationName(pattern[0], name) {
+		return nil
+	}
+
+	return [][]string{pattern[1:]}
+}
+
+// SortedLocationKeys returns the sorted attribute locations of a map, with location patterns first. Options are applied in this
+// order, so options for a specific location take precedence over options for a pattern that matches the same location.
+func SortedLocationKeys[V any](m map[string]V) []string {
+	keys := SortedKeys(m)
+
+	sort.SliceStable(keys, func(i, j int) bool {
+		return IsLocationPattern(keys[i]) && !IsLocationPattern(keys[j])
+	})
+
+	return keys
+}
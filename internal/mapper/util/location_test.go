@@ -0,0 +1,179 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package util_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper/util"
+)
+
+func TestMatchLocation(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern  string
+		location string
+		want     bool
+	}{
+		"literal - match": {
+			pattern:  "category.id",
+			location: "category.id",
+			want:     true,
+		},
+		"literal - no match": {
+			pattern:  "category.id",
+			location: "category.name",
+			want:     false,
+		},
+		"literal - no match for nested": {
+			pattern:  "category",
+			location: "category.id",
+			want:     false,
+		},
+		"wildcard - match": {
+			pattern:  "*.etag",
+			location: "category.etag",
+			want:     true,
+		},
+		"wildcard - no match for top level": {
+			pattern:  "*.etag",
+			location: "etag",
+			want:     false,
+		},
+		"wildcard - no match for deeper nested": {
+			pattern:  "*.etag",
+			location: "category.tags.etag",
+			want:     false,
+		},
+		"trailing wildcard - match": {
+			pattern:  "tags.*",
+			location: "tags.name",
+			want:     true,
+		},
+		"partial wildcard - match": {
+			pattern:  "created_*",
+			location: "created_at",
+			want:     true,
+		},
+		"partial wildcard - no match": {
+			pattern:  "created_*",
+			location: "updated_at",
+			want:     false,
+		},
+		"multiple partial wildcards - match": {
+			pattern:  "*_by_*",
+			location: "created_by_user",
+			want:     true,
+		},
+		"recursive wildcard - match top level": {
+			pattern:  "**._links",
+			location: "_links",
+			want:     true,
+		},
+		"recursive wildcard - match deeper nested": {
+			pattern:  "**._links",
+			location: "category.tags._links",
+			want:     true,
+		},
+		"recursive wildcard - no match": {
+			pattern:  "**._links",
+			location: "category.links",
+			want:     false,
+		},
+		"recursive wildcard in the middle - match": {
+			pattern:  "category.**.id",
+			location: "category.tags.id",
+			want:     true,
+		},
+		"recursive wildcard in the middle - no match for other attribute": {
+			pattern:  "category.**.id",
+			location: "tags.id",
+			want:     false,
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := util.MatchLocation(testCase.pattern, testCase.location)
+			if got != testCase.want {
+				t.Fatalf("unexpected difference, got: %t, wanted: %t", got, testCase.want)
+			}
+		})
+	}
+}
+
+func TestNestedLocationPatterns(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		pattern string
+		name    string
+		want    []string
+	}{
+		"literal": {
+			pattern: "category.id",
+			name:    "category",
+			want:    []string{"id"},
+		},
+		"literal - other attribute": {
+			pattern: "category.id",
+			name:    "tags",
+			want:    nil,
+		},
+		"literal - no nested attributes": {
+			pattern: "category",
+			name:    "category",
+			want:    nil,
+		},
+		"wildcard": {
+			pattern: "*.etag",
+			name:    "category",
+			want:    []string{"etag"},
+		},
+		"recursive wildcard": {
+			pattern: "**.etag",
+			name:    "category",
+			want:    []string{"**.etag"},
+		},
+		"recursive wildcard - matching attribute": {
+			pattern: "**.category.id",
+			name:    "category",
+			want:    []string{"**.category.id", "id"},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := util.NestedLocationPatterns(testCase.pattern, testCase.name)
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}
+
+func TestSortedLocationKeys(t *testing.T) {
+	t.Parallel()
+
+	got := util.SortedLocationKeys(map[string]bool{
+		"name":      true,
+		"**._links": true,
+		"category":  true,
+		"*.etag":    true,
+	})
+
+	want := []string{"**._links", "*.etag", "category", "name"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected difference: %s", diff)
+	}
+}
@@ -26,12 +26,37 @@ const (
 	OAS_format_float    = "float"
 	OAS_format_password = "password"
 
+	OAS_format_int32  = "int32"
+	OAS_format_uint32 = "uint32"
+	OAS_format_uint64 = "uint64"
+
+	OAS_format_date      = "date"
+	OAS_format_date_time = "date-time"
+	OAS_format_email     = "email"
+	OAS_format_hostname  = "hostname"
+	OAS_format_uri       = "uri"
+	OAS_format_uuid      = "uuid"
+	OAS_format_ipv4      = "ipv4"
+	OAS_format_ipv6      = "ipv6"
+	OAS_format_json      = "json"
+
+	// Custom formats for CIDR notation, as there are no registered OAS formats
+	OAS_format_cidr      = "cidr"
+	OAS_format_ipv4_cidr = "ipv4-cidr"
+	OAS_format_ipv6_cidr = "ipv6-cidr"
+
 	OAS_param_path  = "path"
 	OAS_param_query = "query"
 
+	OAS_keyword_one_of = "oneOf"
+	OAS_keyword_any_of = "anyOf"
+
 	// Custom format for SetNested and Set attributes
 	TF_format_set = "set"
 
+	// Custom extension for object schemas that were built from oneOf/anyOf object subschemas
+	TF_extension_object_union = "x-tfplugingen-object-union"
+
 	OAS_mediatype_json = "application/json"
 
 	OAS_response_code_ok      = "200"
@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package suggest
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// maxSuggestions is the maximum number of similar names returned by Similar.
+const maxSuggestions = 5
+
+// Similar returns the candidates that are similar to the target, ordered by similarity. Candidates are similar if they contain
+// the target (or vice versa), or if they are within a small edit distance, ignoring case.
+func Similar(candidates []string, target string) []string {
+	type match struct {
+		name     string
+		distance int
+	}
+
+	lowerTarget := strings.ToLower(target)
+	maxDistance := max(2, len(target)/3)
+
+	matches := []match{}
+	for _, candidate := range candidates {
+		if candidate == "" || slices.ContainsFunc(matches, func(m match) bool { return m.name == candidate }) {
+			continue
+		}
+
+		lowerCandidate := strings.ToLower(candidate)
+		distance := levenshteinDistance(lowerCandidate, lowerTarget)
+
+		if distance <= maxDistance || strings.Contains(lowerCandidate, lowerTarget) || strings.Contains(lowerTarget, lowerCandidate) {
+			matches = append(matches, match{name: candidate, distance: distance})
+		}
+	}
+
+	slices.SortFunc(matches, func(a, b match) int {
+		return cmp.Or(cmp.Compare(a.distance, b.distance), cmp.Compare(a.name, b.name))
+	})
+
+	similar := []string{}
+	for _, m := range matches {
+		if len(similar) == maxSuggestions {
+			break
+		}
+		similar = append(similar, m.name)
+	}
+
+	return similar
+}
+
+// levenshteinDistance returns the minimum number of single character insertions, deletions, or substitutions to change a into b.
+func levenshteinDistance(a string, b string) int {
+	aRunes, bRunes := []rune(a), []rune(b)
+
+	previous := make([]int, len(bRunes)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(aRunes); i++ {
+		current := make([]int, len(bRunes)+1)
+		current[0] = i
+		for j := 1; j <= len(bRunes); j++ {
+			substitutionCost := 1
+			if aRunes[i-1] == bRunes[j-1] {
+				substitutionCost = 0
+			}
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+substitutionCost)
+		}
+		previous = current
+	}
+
+	return previous[len(bRunes)]
+}
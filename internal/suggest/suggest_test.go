@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2023, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package suggest_test
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/suggest"
+)
+
+func TestSimilar(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		candidates []string
+		target     string
+		want       []string
+	}{
+		"no candidates": {
+			candidates: []string{},
+			target:     "name",
+			want:       []string{},
+		},
+		"typo": {
+			candidates: []string{"name", "description", "id"},
+			target:     "nmae",
+			want:       []string{"name"},
+		},
+		"ordered by distance": {
+			candidates: []string{"createResourceV2", "createResources", "listResources", "updateThing"},
+			target:     "createResource",
+			want:       []string{"createResources", "createResourceV2"},
+		},
+		"case insensitive": {
+			candidates: []string{"UserID", "user_name"},
+			target:     "userid",
+			want:       []string{"UserID"},
+		},
+		"contains": {
+			candidates: []string{"config.settings.timeout", "config.name"},
+			target:     "settings.timeout",
+			want:       []string{"config.settings.timeout"},
+		},
+		"duplicates removed": {
+			candidates: []string{"name", "name"},
+			target:     "nam",
+			want:       []string{"name"},
+		},
+		"limited number of suggestions": {
+			candidates: []string{"a1", "a2", "a3", "a4", "a5", "a6"},
+			target:     "a",
+			want:       []string{"a1", "a2", "a3", "a4", "a5"},
+		},
+	}
+
+	for name, testCase := range testCases {
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := suggest.Similar(testCase.candidates, testCase.target)
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected difference: %s", diff)
+			}
+		})
+	}
+}